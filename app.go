@@ -1,6 +1,12 @@
 package podio
 
-import "fmt"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
 
 type App struct {
 	Id              int64  `json:"app_id"`
@@ -19,23 +25,595 @@ type App struct {
 	Icon            string `json:"icon"`
 }
 
+// AppField describes a field in the schema of an app
+type AppField struct {
+	Id         int64          `json:"field_id"`
+	ExternalId string         `json:"external_id"`
+	Type       string         `json:"type"`
+	Label      string         `json:"label"`
+	Status     string         `json:"status"`
+	Config     AppFieldConfig `json:"config"`
+}
+
+// AppFieldConfig is the configuration of an app field. The type specific settings,
+// such as the options of a category field, are found in Settings.
+type AppFieldConfig struct {
+	Label         string                 `json:"label"`
+	Description   string                 `json:"description"`
+	Required      bool                   `json:"required"`
+	Unique        bool                   `json:"unique"`
+	HiddenIfEmpty bool                   `json:"hidden"`
+	AlwaysHidden  bool                   `json:"hidden_create_view_edit"`
+	Delta         int                    `json:"delta"`
+	Settings      map[string]interface{} `json:"settings"`
+}
+
+// AppFull is an app along with the fields of its schema
+type AppFull struct {
+	App
+	Fields []*AppField `json:"fields"`
+}
+
+// GetFieldByExternalID returns the first field with the given external ID, or nil
+// if the app has no such field.
+func (app *AppFull) GetFieldByExternalID(externalId string) *AppField {
+	for _, field := range app.Fields {
+		if field.ExternalId == externalId {
+			return field
+		}
+	}
+	return nil
+}
+
+// Options returns the options of a category field, including deleted options.
+func (field *AppField) Options() ([]CategoryOption, error) {
+	if field.Type != "category" {
+		return nil, &FieldTypeError{FieldId: field.Id, Type: field.Type, Expected: "category"}
+	}
+
+	options := []CategoryOption{}
+	raw, ok := field.Config.Settings["options"]
+	if !ok {
+		return options, nil
+	}
+
+	buf, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(buf, &options)
+	return options, err
+}
+
+// GetFieldOptions returns the options of the category field with the given external
+// id. The app is fetched with GetAppWithFields, so the app cache is used if set.
+func (client *Client) GetFieldOptions(ctx context.Context, appId int64, externalId string) ([]CategoryOption, error) {
+	app, err := client.GetAppWithFields(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	field := app.GetFieldByExternalID(externalId)
+	if field == nil {
+		return nil, fmt.Errorf("podio: app %d has no field with external id %q", appId, externalId)
+	}
+	return field.Options()
+}
+
+// getAppFieldByExternalID returns the field of an app with the given external id,
+// fetched from Podio rather than the app cache, along with its configuration as Podio
+// sent it, including the settings AppFieldConfig leaves out
+func (client *Client) getAppFieldByExternalID(ctx context.Context, appId int64, externalId string) (*AppField, map[string]interface{}, error) {
+	fields := []json.RawMessage{}
+	path := fmt.Sprintf("/app/%d/field", appId)
+	if err := client.requestContext(ctx, "GET", path, nil, nil, &fields); err != nil {
+		return nil, nil, err
+	}
+
+	for _, buf := range fields {
+		field := &AppField{}
+		if err := json.Unmarshal(buf, field); err != nil {
+			return nil, nil, err
+		}
+		if field.ExternalId != externalId {
+			continue
+		}
+
+		raw := struct {
+			Config map[string]interface{} `json:"config"`
+		}{}
+		dec := json.NewDecoder(bytes.NewReader(buf))
+		dec.UseNumber() // keep ids as they are
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+		if raw.Config == nil {
+			raw.Config = map[string]interface{}{}
+		}
+		return field, raw.Config, nil
+	}
+	return nil, nil, fmt.Errorf("podio: app %d has no field with external id %q", appId, externalId)
+}
+
+// updateFieldConfig sends the configuration of a field to Podio. The configuration is
+// sent in full, as Podio replaces the configuration of a field, so config should be
+// the configuration from getAppFieldByExternalID with only the changed keys modified.
+func (client *Client) updateFieldConfig(ctx context.Context, appId, fieldId int64, config map[string]interface{}) error {
+	return client.UpdateAppField(ctx, appId, fieldId, &AppFieldRequest{Config: config})
+}
+
+// configOptions returns the options in the settings of a field configuration, in the
+// order of AppField.Options
+func configOptions(config map[string]interface{}) []interface{} {
+	settings, _ := config["settings"].(map[string]interface{})
+	options, _ := settings["options"].([]interface{})
+	return options
+}
+
+// setConfigOptions replaces the options in the settings of a field configuration
+func setConfigOptions(config map[string]interface{}, options []interface{}) {
+	settings, _ := config["settings"].(map[string]interface{})
+	if settings == nil {
+		settings = map[string]interface{}{}
+		config["settings"] = settings
+	}
+	settings["options"] = options
+}
+
+// SetFieldRequired makes the field with the given external id required or optional
+// on new and updated items.
+func (client *Client) SetFieldRequired(ctx context.Context, appId int64, externalId string, required bool) error {
+	field, config, err := client.getAppFieldByExternalID(ctx, appId, externalId)
+	if err != nil {
+		return err
+	}
+
+	config["required"] = required
+	return client.updateFieldConfig(ctx, appId, field.Id, config)
+}
+
+// SetFieldOptional makes the field with the given external id optional, see SetFieldRequired.
+func (client *Client) SetFieldOptional(ctx context.Context, appId int64, externalId string) error {
+	return client.SetFieldRequired(ctx, appId, externalId, false)
+}
+
+// AddCategoryOption adds an option to the category field with the given external id
+// and returns the new option.
+func (client *Client) AddCategoryOption(ctx context.Context, appId int64, externalId, text, color string) (*CategoryOption, error) {
+	field, config, err := client.getAppFieldByExternalID(ctx, appId, externalId)
+	if err != nil {
+		return nil, err
+	}
+	options, err := field.Options()
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[int]bool{}
+	for _, option := range options {
+		known[option.Id] = true
+	}
+
+	setConfigOptions(config, append(configOptions(config), map[string]interface{}{
+		"status": "active",
+		"text":   text,
+		"color":  color,
+	}))
+	if err := client.updateFieldConfig(ctx, appId, field.Id, config); err != nil {
+		return nil, err
+	}
+
+	// Podio assigns the id of the option, so it is read back
+	field, err = client.GetAppField(ctx, appId, field.Id)
+	if err != nil {
+		return nil, err
+	}
+	options, err = field.Options()
+	if err != nil {
+		return nil, err
+	}
+	for _, option := range options {
+		if !known[option.Id] && option.Text == text {
+			return &option, nil
+		}
+	}
+	return nil, fmt.Errorf("podio: option %q was not added to field %d", text, field.Id)
+}
+
+// RemoveCategoryOption removes an option from the category field with the given external
+// id. Podio keeps the option as deleted on items that have it.
+func (client *Client) RemoveCategoryOption(ctx context.Context, appId int64, externalId string, optionId int) error {
+	field, config, err := client.getAppFieldByExternalID(ctx, appId, externalId)
+	if err != nil {
+		return err
+	}
+	options, err := field.Options()
+	if err != nil {
+		return err
+	}
+
+	raw := configOptions(config)
+	kept := []interface{}{}
+	for i, option := range options {
+		if option.Id != optionId {
+			kept = append(kept, raw[i])
+		}
+	}
+	if len(kept) == len(options) {
+		return fmt.Errorf("podio: field %d has no option %d", field.Id, optionId)
+	}
+
+	setConfigOptions(config, kept)
+	return client.updateFieldConfig(ctx, appId, field.Id, config)
+}
+
+// GetAppWithFields returns an app with its fields. If the client has an app cache (see
+// WithAppCache), the app is taken from the cache if present, and stored in it otherwise.
+// Callers get their own copy of a cached app, so they may modify it.
+//
+// https://developers.podio.com/doc/applications/get-app-22349
+func (client *Client) GetAppWithFields(ctx context.Context, appId int64) (*AppFull, error) {
+	if client.appCache != nil {
+		if app, ok := client.appCache.Get(appId); ok {
+			return copyAppFull(app)
+		}
+	}
+
+	app := &AppFull{}
+	path := fmt.Sprintf("/app/%d?view=%s", appId, AppViewFull)
+	err := client.requestContext(ctx, "GET", path, nil, nil, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.appCache != nil {
+		cached, err := copyAppFull(app)
+		if err != nil {
+			return nil, err
+		}
+		client.appCache.Set(cached)
+	}
+	return app, nil
+}
+
+// copyAppFull returns a deep copy of an app. The app only holds JSON values, so it is
+// copied by encoding and decoding it.
+func copyAppFull(app *AppFull) (*AppFull, error) {
+	buf, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+	c := &AppFull{}
+	err = json.Unmarshal(buf, c)
+	return c, err
+}
+
+// forgetApp removes an app changed through the client from the app cache, if any
+func (client *Client) forgetApp(appId int64) {
+	if client.appCache != nil {
+		client.appCache.Delete(appId)
+	}
+}
+
+// GetApps returns the apps of a space. If the client was created with WithSpaceAppCache,
+// the apps are cached per space.
+//
 // https://developers.podio.com/doc/applications/get-apps-by-space-22478
 func (client *Client) GetApps(spaceId int64) (apps []App, err error) {
+	return client.getAppsContext(context.Background(), spaceId)
+}
+
+// getAppsContext is GetApps, but the request is aborted when ctx is cancelled
+func (client *Client) getAppsContext(ctx context.Context, spaceId int64) (apps []App, err error) {
+	if client.spaceAppCache != nil {
+		if cached, ok := client.spaceAppCache.get(spaceId); ok {
+			return append([]App(nil), cached.([]App)...), nil
+		}
+	}
+
 	path := fmt.Sprintf("/app/space/%d?view=micro", spaceId)
-	err = client.Request("GET", path, nil, nil, &apps)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &apps)
+
+	if err == nil && client.spaceAppCache != nil {
+		client.spaceAppCache.set(spaceId, append([]App(nil), apps...))
+	}
 	return
 }
 
+// Views of an app, from the least to the most detailed, for use with GetAppView.
+const (
+	AppViewMicro = "micro"
+	AppViewMini  = "mini"
+	AppViewShort = "short"
+	AppViewFull  = "full"
+)
+
 // https://developers.podio.com/doc/applications/get-app-22349
 func (client *Client) GetApp(id int64) (app *App, err error) {
-	path := fmt.Sprintf("/app/%d?view=micro", id)
-	err = client.Request("GET", path, nil, nil, &app)
+	return client.GetAppView(context.Background(), id, AppViewMicro)
+}
+
+// GetAppView returns an app in the given view (AppViewMicro, AppViewShort, ...).
+//
+// https://developers.podio.com/doc/applications/get-app-22349
+func (client *Client) GetAppView(ctx context.Context, id int64, view string) (app *App, err error) {
+	path := fmt.Sprintf("/app/%d?view=%s", id, view)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &app)
 	return
 }
 
+// GetAuthenticatedApp returns the app a client authenticated with AuthWithAppCredentials
+// acts as. Podio cannot look up an app from its app token alone, but authenticating
+// with an app id and token and calling GetAuthenticatedApp verifies that the two match.
+// It returns an error if the client is not authenticated as an app.
+func (client *Client) GetAuthenticatedApp(ctx context.Context) (*App, error) {
+	ref := client.authToken.Ref
+	id, ok := ref["id"].(float64)
+	if ref["type"] != "app" || !ok {
+		return nil, errors.New("podio: client is not authenticated as an app")
+	}
+
+	return client.GetAppView(ctx, int64(id), AppViewMicro)
+}
+
 // https://developers.podio.com/doc/applications/get-app-on-space-by-url-label-477105
 func (client *Client) GetAppBySpaceIdAndSlug(spaceId int64, slug string) (app *App, err error) {
 	path := fmt.Sprintf("/app/space/%d/%s", spaceId, slug)
 	err = client.Request("GET", path, nil, nil, &app)
 	return
 }
+
+// GetAppFields returns the field schema of an app.
+//
+// https://developers.podio.com/doc/applications/get-app-field-22353
+func (client *Client) GetAppFields(ctx context.Context, appId int64) (fields []*AppField, err error) {
+	path := fmt.Sprintf("/app/%d/field", appId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &fields)
+	return
+}
+
+// https://developers.podio.com/doc/applications/get-app-field-22353
+func (client *Client) GetAppField(ctx context.Context, appId, fieldId int64) (field *AppField, err error) {
+	path := fmt.Sprintf("/app/%d/field/%d", appId, fieldId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &field)
+	return
+}
+
+// AppFieldRequest describes a field to add to an app, or the new configuration of an existing field.
+type AppFieldRequest struct {
+	// Type of the field (text, number, category, ...). Cannot be changed on existing fields.
+	Type string
+
+	// Config holds the label, description, settings etc. of the field.
+	Config map[string]interface{}
+
+	// Position of the field in the app, if not nil. It is sent as the delta of the config.
+	Position *int
+}
+
+func (req *AppFieldRequest) config() map[string]interface{} {
+	config := map[string]interface{}{}
+	for k, v := range req.Config {
+		config[k] = v
+	}
+	if req.Position != nil {
+		config["delta"] = *req.Position
+	}
+	return config
+}
+
+// CreateAppField adds a field to an app and returns the id of the new field.
+//
+// https://developers.podio.com/doc/applications/add-new-app-field-22354
+func (client *Client) CreateAppField(ctx context.Context, appId int64, req *AppFieldRequest) (int64, error) {
+	path := fmt.Sprintf("/app/%d/field/", appId)
+	params := map[string]interface{}{
+		"type":   req.Type,
+		"config": req.config(),
+	}
+
+	rsp := &struct {
+		FieldId int64 `json:"field_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", path, nil, params, rsp)
+	client.forgetApp(appId)
+
+	return rsp.FieldId, err
+}
+
+// UpdateAppField updates the configuration of an app field. The type of the field cannot be changed.
+//
+// https://developers.podio.com/doc/applications/update-an-app-field-22356
+func (client *Client) UpdateAppField(ctx context.Context, appId, fieldId int64, req *AppFieldRequest) error {
+	path := fmt.Sprintf("/app/%d/field/%d", appId, fieldId)
+	defer client.forgetApp(appId)
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, req.config(), nil)
+}
+
+// DeleteAppField removes a field from an app, along with the values of the field on all items.
+//
+// https://developers.podio.com/doc/applications/delete-app-field-22355
+func (client *Client) DeleteAppField(ctx context.Context, appId, fieldId int64) error {
+	path := fmt.Sprintf("/app/%d/field/%d", appId, fieldId)
+	defer client.forgetApp(appId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// CreateAppRequest describes a new app. An app needs a name and an item name.
+type CreateAppRequest struct {
+	Name        string
+	ItemName    string // Name of a single item in the app (e.g. "Task" for an app named "Tasks")
+	Description string
+	Icon        string
+	Fields      []AppFieldRequest
+}
+
+// CreateApp creates an app in a space and returns the new app as GetApp does. Podio
+// only responds with the id of the app, so the app is fetched with a second request;
+// if that request fails, the returned app holds only the id.
+//
+// https://developers.podio.com/doc/applications/add-new-app-22351
+func (client *Client) CreateApp(ctx context.Context, spaceId int64, req *CreateAppRequest) (*App, error) {
+	fields := make([]map[string]interface{}, 0, len(req.Fields))
+	for _, field := range req.Fields {
+		fields = append(fields, map[string]interface{}{
+			"type":   field.Type,
+			"config": field.config(),
+		})
+	}
+
+	params := map[string]interface{}{
+		"space_id": spaceId,
+		"config":   appConfig(req.Name, req.ItemName, req.Description, req.Icon),
+		"fields":   fields,
+	}
+
+	rsp := &struct {
+		AppId int64 `json:"app_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", "/app/", nil, params, rsp)
+	if client.spaceAppCache != nil {
+		client.spaceAppCache.delete(spaceId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getCreatedApp(ctx, rsp.AppId)
+}
+
+// getCreatedApp fetches an app that was just created, falling back to an app holding
+// only the id if the app cannot be fetched
+func (client *Client) getCreatedApp(ctx context.Context, appId int64) (*App, error) {
+	app, err := client.GetAppView(ctx, appId, AppViewMicro)
+	if err != nil {
+		return &App{Id: appId}, err
+	}
+	return app, nil
+}
+
+// UpdateAppRequest holds the new configuration of an app. Empty values are left unchanged.
+type UpdateAppRequest struct {
+	Name        string
+	ItemName    string
+	Description string
+	Icon        string
+}
+
+// UpdateApp updates the configuration of an app
+//
+// https://developers.podio.com/doc/applications/update-app-22352
+func (client *Client) UpdateApp(ctx context.Context, appId int64, req *UpdateAppRequest) error {
+	path := fmt.Sprintf("/app/%d", appId)
+	params := map[string]interface{}{
+		"config": appConfig(req.Name, req.ItemName, req.Description, req.Icon),
+	}
+
+	defer client.forgetApp(appId)
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, params, nil)
+}
+
+// appConfig builds the config of an app request, omitting empty values
+func appConfig(name, itemName, description, icon string) map[string]interface{} {
+	config := map[string]interface{}{}
+	if name != "" {
+		config["name"] = name
+	}
+	if itemName != "" {
+		config["item_name"] = itemName
+	}
+	if description != "" {
+		config["description"] = description
+	}
+	if icon != "" {
+		config["icon"] = icon
+	}
+	return config
+}
+
+// DeleteApp deletes an app along with all of its items
+//
+// https://developers.podio.com/doc/applications/delete-app-43693
+func (client *Client) DeleteApp(ctx context.Context, appId int64) error {
+	path := fmt.Sprintf("/app/%d", appId)
+	err := client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+	client.forgetApp(appId)
+	if client.spaceAppCache != nil {
+		// the space of the app is unknown here
+		client.spaceAppCache.clear()
+	}
+	return err
+}
+
+// CloneApp installs a copy of an app in the target space and returns the copy. Like
+// CreateApp, it fetches the copy with a second request.
+//
+// https://developers.podio.com/doc/applications/install-app-22506
+func (client *Client) CloneApp(ctx context.Context, appId, targetSpaceId int64) (*App, error) {
+	path := fmt.Sprintf("/app/%d/install", appId)
+	params := map[string]interface{}{
+		"space_id": targetSpaceId,
+	}
+
+	rsp := &struct {
+		AppId int64 `json:"app_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", path, nil, params, rsp)
+	if client.spaceAppCache != nil {
+		client.spaceAppCache.delete(targetSpaceId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getCreatedApp(ctx, rsp.AppId)
+}
+
+// AppRevision is a revision of the schema of an app
+type AppRevision struct {
+	Revision   int         `json:"revision"`
+	CreatedOn  Time        `json:"created_on"`
+	CreatedBy  ByLine      `json:"created_by"`
+	CreatedVia Via         `json:"created_via"`
+	Fields     []*AppField `json:"fields"`
+}
+
+// GetAppRevisions returns the revisions of the schema of an app
+func (client *Client) GetAppRevisions(ctx context.Context, appId int64) (revisions []*AppRevision, err error) {
+	path := fmt.Sprintf("/app/%d/revision", appId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &revisions)
+	return
+}
+
+// GetAppRevision returns a single revision of the schema of an app
+func (client *Client) GetAppRevision(ctx context.Context, appId int64, revision int) (rev *AppRevision, err error) {
+	path := fmt.Sprintf("/app/%d/revision/%d", appId, revision)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &rev)
+	return
+}
+
+// RevertApp reverts the schema of an app to the given revision
+func (client *Client) RevertApp(ctx context.Context, appId int64, revision int) error {
+	path := fmt.Sprintf("/app/%d/revert/%d", appId, revision)
+	defer client.forgetApp(appId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// ActivateApp activates a deactivated app
+//
+// https://developers.podio.com/doc/applications/activate-app-43822
+func (client *Client) ActivateApp(ctx context.Context, appId int64) error {
+	path := fmt.Sprintf("/app/%d/activate", appId)
+	defer client.forgetApp(appId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// DeactivateApp deactivates an app. No new items can be created in a deactivated
+// app, but the existing items can still be read.
+//
+// https://developers.podio.com/doc/applications/deactivate-app-43821
+func (client *Client) DeactivateApp(ctx context.Context, appId int64) error {
+	path := fmt.Sprintf("/app/%d/deactivate", appId)
+	defer client.forgetApp(appId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
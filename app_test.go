@@ -0,0 +1,464 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthenticatedApp(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/app/18166054", req.URL.Path)
+		w.Write([]byte(`{"app_id": 18166054, "name": "Tasks"}`))
+	})
+
+	_, err := client.GetAuthenticatedApp(context.Background())
+	r.EqualError(err, "podio: client is not authenticated as an app")
+
+	// the ref of a token returned by AuthWithAppCredentials
+	client.authToken.Ref = map[string]interface{}{"type": "app", "id": float64(18166054)}
+	app, err := client.GetAuthenticatedApp(context.Background())
+	r.NoError(err)
+	r.Equal(int64(18166054), app.Id)
+	r.Equal("Tasks", app.Name)
+}
+
+func TestGetAppView(t *testing.T) {
+	r := require.New(t)
+
+	var views []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/app/18166054", req.URL.Path)
+		views = append(views, req.URL.Query().Get("view"))
+		w.Write([]byte(`{
+			"app_id": 18166054,
+			"status": "active",
+			"space_id": 2720177,
+			"default_view_id": 31384275,
+			"current_revision": 7,
+			"url_label": "tasks",
+			"link": "https://podio.com/podio/sandbox/apps/tasks"
+		}`))
+	})
+
+	app, err := client.GetAppView(context.Background(), 18166054, AppViewShort)
+	r.NoError(err)
+	r.Equal(int64(18166054), app.Id)
+	r.Equal("active", app.Status)
+	r.Equal(2720177, app.SpaceId)
+	r.Equal(31384275, app.DefaultViewId)
+	r.Equal(7, app.CurrentRevision)
+	r.Equal("tasks", app.URLLabel)
+	r.Equal("https://podio.com/podio/sandbox/apps/tasks", app.Link)
+
+	_, err = client.GetApp(18166054)
+	r.NoError(err)
+	r.Equal([]string{"short", "micro"}, views)
+}
+
+func TestGetAppFields(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/app/18166054/field", req.URL.Path)
+		w.Write(getFixtureJSON(t, "fixtures/app_fields_18166054.json"))
+	})
+
+	fields, err := client.GetAppFields(context.Background(), 18166054)
+	r.NoError(err)
+	r.Len(fields, 2)
+
+	title := fields[0]
+	r.Equal(int64(142837426), title.Id)
+	r.Equal("title", title.ExternalId)
+	r.Equal("text", title.Type)
+	r.Equal("Title", title.Label)
+	r.Equal("active", title.Status)
+	r.Equal("The title of the test", title.Config.Description)
+	r.True(title.Config.Required)
+	r.Equal("plain", title.Config.Settings["format"])
+
+	status := fields[1]
+	r.Equal("category", status.Type)
+	r.False(status.Config.Required)
+	r.True(status.Config.HiddenIfEmpty)
+	r.Equal(1, status.Config.Delta)
+	r.Len(status.Config.Settings["options"], 2)
+}
+
+const categoryAppJSON = `{
+	"app_id": 18166054,
+	"fields": [
+		{"field_id": 142837426, "type": "text", "external_id": "title", "config": {"label": "Title"}},
+		{"field_id": 142837427, "type": "category", "external_id": "status", "config": {
+			"label": "Status",
+			"settings": {"multiple": false, "options": [
+				{"id": 1, "status": "active", "text": "Open", "color": "DCEBD8"},
+				{"id": 2, "status": "deleted", "text": "Closed", "color": "F7F0C5"}
+			]}
+		}}
+	]
+}`
+
+func TestGetFieldOptions(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/app/18166054", req.URL.Path)
+		r.Equal("full", req.URL.Query().Get("view"))
+		w.Write([]byte(categoryAppJSON))
+	})
+
+	options, err := client.GetFieldOptions(context.Background(), 18166054, "status")
+	r.NoError(err)
+	r.Equal([]CategoryOption{
+		{Id: 1, Status: "active", Text: "Open", Color: "DCEBD8"},
+		{Id: 2, Status: "deleted", Text: "Closed", Color: "F7F0C5"},
+	}, options)
+
+	_, err = client.GetFieldOptions(context.Background(), 18166054, "title")
+	r.IsType(&FieldTypeError{}, err)
+
+	_, err = client.GetFieldOptions(context.Background(), 18166054, "priority")
+	r.EqualError(err, `podio: app 18166054 has no field with external id "priority"`)
+}
+
+const categoryFieldsJSON = `[
+	{"field_id": 142837427, "type": "category", "external_id": "status", "config": {
+		"label": "Status",
+		"required": true,
+		"mapping": "status",
+		"settings": {"multiple": false, "display": "inline", "options": [{"id": 1, "status": "active", "text": "Open", "color": "DCEBD8", "pinned": true}]}
+	}}
+]`
+
+func TestAddCategoryOption(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method + " " + req.URL.Path {
+		case "GET /app/18166054/field":
+			w.Write([]byte(categoryFieldsJSON))
+		case "PUT /app/18166054/field/142837427":
+			body, err := ioutil.ReadAll(req.Body)
+			r.NoError(err)
+			// settings that AppFieldConfig leaves out are sent back unchanged
+			r.JSONEq(`{
+				"label": "Status",
+				"required": true,
+				"mapping": "status",
+				"settings": {"multiple": false, "display": "inline", "options": [
+					{"id": 1, "status": "active", "text": "Open", "color": "DCEBD8", "pinned": true},
+					{"status": "active", "text": "Waiting", "color": "FFD5C2"}
+				]}
+			}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /app/18166054/field/142837427":
+			w.Write([]byte(`{"field_id": 142837427, "type": "category", "config": {"settings": {"options": [
+				{"id": 1, "status": "active", "text": "Open", "color": "DCEBD8"},
+				{"id": 3, "status": "active", "text": "Waiting", "color": "FFD5C2"}
+			]}}}`))
+		}
+	})
+
+	option, err := client.AddCategoryOption(context.Background(), 18166054, "status", "Waiting", "FFD5C2")
+	r.NoError(err)
+	r.Equal(&CategoryOption{Id: 3, Status: "active", Text: "Waiting", Color: "FFD5C2"}, option)
+	r.Equal([]string{
+		"GET /app/18166054/field",
+		"PUT /app/18166054/field/142837427",
+		"GET /app/18166054/field/142837427",
+	}, requests)
+}
+
+func TestRemoveCategoryOption(t *testing.T) {
+	r := require.New(t)
+
+	var puts int
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			w.Write([]byte(categoryFieldsJSON))
+			return
+		}
+
+		puts++
+		r.Equal("PUT", req.Method)
+		r.Equal("/app/18166054/field/142837427", req.URL.Path)
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"label": "Status",
+			"required": true,
+			"mapping": "status",
+			"settings": {"multiple": false, "display": "inline", "options": []}
+		}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.RemoveCategoryOption(context.Background(), 18166054, "status", 1))
+	r.EqualError(client.RemoveCategoryOption(context.Background(), 18166054, "status", 2), "podio: field 142837427 has no option 2")
+	r.Equal(1, puts)
+}
+
+func TestSetFieldRequired(t *testing.T) {
+	r := require.New(t)
+
+	var required []bool
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			r.Equal("/app/18166054/field", req.URL.Path)
+			w.Write([]byte(categoryFieldsJSON))
+			return
+		}
+
+		r.Equal("PUT", req.Method)
+		r.Equal("/app/18166054/field/142837427", req.URL.Path)
+		config := map[string]interface{}{}
+		r.NoError(json.NewDecoder(req.Body).Decode(&config))
+		r.Equal("Status", config["label"])
+		r.Equal("status", config["mapping"])
+		r.Equal("inline", config["settings"].(map[string]interface{})["display"])
+		required = append(required, config["required"].(bool))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.SetFieldOptional(context.Background(), 18166054, "status"))
+	r.NoError(client.SetFieldRequired(context.Background(), 18166054, "status", true))
+	r.Equal([]bool{false, true}, required)
+
+	r.EqualError(client.SetFieldRequired(context.Background(), 18166054, "priority", true), `podio: app 18166054 has no field with external id "priority"`)
+}
+
+func TestCreateAppField(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/app/18166054/field/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"type": "text", "config": {"label": "Notes", "required": true, "delta": 2}}`, string(body))
+
+		w.Write([]byte(`{"field_id": 142837429}`))
+	})
+
+	position := 2
+	fieldId, err := client.CreateAppField(context.Background(), 18166054, &AppFieldRequest{
+		Type:     "text",
+		Config:   map[string]interface{}{"label": "Notes", "required": true},
+		Position: &position,
+	})
+	r.NoError(err)
+	r.Equal(int64(142837429), fieldId)
+}
+
+func TestUpdateAppField(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("PUT", req.Method)
+		r.Equal("/app/18166054/field/142837429", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"label": "Comments"}`, string(body))
+
+		w.Write([]byte(`{"revision": 3}`))
+	})
+
+	err := client.UpdateAppField(context.Background(), 18166054, 142837429, &AppFieldRequest{
+		Config: map[string]interface{}{"label": "Comments"},
+	})
+	r.NoError(err)
+}
+
+func TestDeleteAppField(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/app/18166054/field/142837429", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteAppField(context.Background(), 18166054, 142837429))
+}
+
+func TestCreateApp(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "GET" {
+			r.Equal("micro", req.URL.Query().Get("view"))
+			w.Write([]byte(`{"app_id": 18166055, "name": "Tasks", "item_name": "Task", "space_id": 2720177}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"space_id": 2720177,
+			"config": {"name": "Tasks", "item_name": "Task", "description": "Things to do"},
+			"fields": [{"type": "text", "config": {"label": "Title"}}]
+		}`, string(body))
+
+		w.Write([]byte(`{"app_id": 18166055}`))
+	})
+
+	app, err := client.CreateApp(context.Background(), 2720177, &CreateAppRequest{
+		Name:        "Tasks",
+		ItemName:    "Task",
+		Description: "Things to do",
+		Fields: []AppFieldRequest{
+			{Type: "text", Config: map[string]interface{}{"label": "Title"}},
+		},
+	})
+	r.NoError(err)
+	r.Equal(&App{Id: 18166055, Name: "Tasks", ItemName: "Task", SpaceId: 2720177}, app)
+	r.Equal([]string{"POST /app/", "GET /app/18166055"}, requests)
+}
+
+func TestCreateAppNotFetched(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "unavailable", "error_description": "Service unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"app_id": 18166055}`))
+	})
+
+	// the app was created, so its id is returned along with the error
+	app, err := client.CreateApp(context.Background(), 2720177, &CreateAppRequest{Name: "Tasks", ItemName: "Task"})
+	r.Error(err)
+	r.Equal(&App{Id: 18166055}, app)
+}
+
+func TestUpdateApp(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("PUT", req.Method)
+		r.Equal("/app/18166055", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"config": {"name": "Chores"}}`, string(body))
+	})
+
+	r.NoError(client.UpdateApp(context.Background(), 18166055, &UpdateAppRequest{Name: "Chores"}))
+}
+
+func TestDeleteApp(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/app/18166055", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteApp(context.Background(), 18166055))
+}
+
+func TestCloneApp(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "GET" {
+			w.Write([]byte(`{"app_id": 18166056, "name": "Tasks", "space_id": 2720178}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"space_id": 2720178}`, string(body))
+
+		w.Write([]byte(`{"app_id": 18166056}`))
+	})
+
+	app, err := client.CloneApp(context.Background(), 18166055, 2720178)
+	r.NoError(err)
+	r.Equal(int64(18166056), app.Id)
+	r.Equal(2720178, app.SpaceId)
+	r.Equal([]string{"POST /app/18166055/install", "GET /app/18166056"}, requests)
+}
+
+func TestAppRevisions(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /app/18166054/revision":
+			w.Write([]byte(`[{"revision": 1, "created_on": "2017-03-21 23:29:07"}, {"revision": 2, "created_on": "2017-03-22 10:00:00"}]`))
+		case "GET /app/18166054/revision/2":
+			w.Write([]byte(`{"revision": 2, "created_on": "2017-03-22 10:00:00", "fields": [{"field_id": 142837426, "type": "text"}]}`))
+		case "POST /app/18166054/revert/1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	revisions, err := client.GetAppRevisions(context.Background(), 18166054)
+	r.NoError(err)
+	r.Len(revisions, 2)
+	r.Equal(1, revisions[0].Revision)
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), revisions[0].CreatedOn)
+
+	revision, err := client.GetAppRevision(context.Background(), 18166054, 2)
+	r.NoError(err)
+	r.Equal(2, revision.Revision)
+	r.Len(revision.Fields, 1)
+	r.Equal(int64(142837426), revision.Fields[0].Id)
+
+	r.NoError(client.RevertApp(context.Background(), 18166054, 1))
+}
+
+func TestActivateApp(t *testing.T) {
+	r := require.New(t)
+
+	var paths []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		paths = append(paths, req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.ActivateApp(context.Background(), 18166054))
+	r.NoError(client.DeactivateApp(context.Background(), 18166054))
+	r.Equal([]string{"/app/18166054/activate", "/app/18166054/deactivate"}, paths)
+}
+
+func TestDeactivateAppError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "The user does not have the right 'grant' on the app with id 18166054"}`))
+	})
+
+	err := client.DeactivateApp(context.Background(), 18166054)
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected error to be *podio.Error, is %#v", err)
+	r.Equal("forbidden", podioErr.Type)
+	r.Equal("forbidden: The user does not have the right 'grant' on the app with id 18166054", err.Error())
+}
@@ -69,7 +69,7 @@ func AuthWithAuthCode(clientId, clientSecret, authCode, redirectUri string) (*Au
 func authRequest(data url.Values) (*AuthToken, error) {
 	var authToken AuthToken
 
-	resp, err := http.PostForm("https://api.podio.com/oauth/token", data)
+	resp, err := http.PostForm(defaultBaseURL+"/oauth/token", data)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +86,7 @@ func authRequest(data url.Values) (*AuthToken, error) {
 		if err != nil {
 			return nil, errors.New(string(respBody))
 		}
+		podioErr.HTTPStatusCode = resp.StatusCode
 		return nil, podioErr
 	}
 
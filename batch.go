@@ -0,0 +1,82 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// maxBatchSize is the largest number of operations Podio accepts in a single batch
+const maxBatchSize = 10
+
+// BatchRequest collects API operations to send to Podio in a single request.
+// The zero value is an empty batch.
+type BatchRequest struct {
+	operations []batchOperation
+}
+
+type batchOperation struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Body   interface{} `json:"body,omitempty"`
+}
+
+// BatchResponse is the response to a single operation of a batch
+type BatchResponse struct {
+	StatusCode int             `json:"code"`
+	Body       json.RawMessage `json:"body"`
+}
+
+// NewBatchRequest returns an empty batch
+func NewBatchRequest() *BatchRequest {
+	return &BatchRequest{}
+}
+
+// Add appends an operation to the batch. path is relative to the API root, e.g.
+// "/item/123", like the paths given to Request. body is encoded as JSON and may be nil.
+func (batch *BatchRequest) Add(method, path string, body interface{}) *BatchRequest {
+	batch.operations = append(batch.operations, batchOperation{Method: method, URL: path, Body: body})
+	return batch
+}
+
+// Execute sends the operations of the batch to Podio and returns their responses in
+// the order the operations were added. The batch fails as a whole only if the request
+// itself fails; operations that fail on their own are reported in their responses,
+// see BatchResponse.Unmarshal.
+func (batch *BatchRequest) Execute(ctx context.Context, client *Client) ([]*BatchResponse, error) {
+	if len(batch.operations) == 0 {
+		return nil, errors.New("podio: batch has no operations")
+	}
+	if len(batch.operations) > maxBatchSize {
+		return nil, fmt.Errorf("podio: batch has %d operations, at most %d are allowed", len(batch.operations), maxBatchSize)
+	}
+
+	params := map[string]interface{}{
+		"requests": batch.operations,
+	}
+
+	responses := []*BatchResponse{}
+	err := client.requestWithParamsContext(ctx, "POST", "/batch/", nil, params, &responses)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(responses) != len(batch.operations) {
+		return nil, fmt.Errorf("podio: batch of %d operations got %d responses", len(batch.operations), len(responses))
+	}
+	return responses, nil
+}
+
+// Unmarshal decodes the body of the response into out. If the operation failed, its
+// error is returned instead, like the errors of Client.Request.
+func (rsp *BatchResponse) Unmarshal(out interface{}) error {
+	if !(200 <= rsp.StatusCode && rsp.StatusCode < 300) {
+		return newResponseError(rsp.StatusCode, nil, rsp.Body)
+	}
+
+	if out == nil || len(rsp.Body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(rsp.Body, out)
+}
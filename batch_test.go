@@ -0,0 +1,88 @@
+package podio
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchRequest(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/batch/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"requests": [
+			{"method": "GET", "url": "/item/1"},
+			{"method": "PUT", "url": "/item/2", "body": {"title": "Updated"}},
+			{"method": "GET", "url": "/item/3"}
+		]}`, string(body))
+
+		w.Write([]byte(`[
+			{"code": 200, "body": {"item_id": 1, "title": "First"}},
+			{"code": 204},
+			{"code": 404, "body": {"error": "not_found", "error_description": "Object not found"}}
+		]`))
+	})
+
+	batch := NewBatchRequest().
+		Add("GET", "/item/1", nil).
+		Add("PUT", "/item/2", map[string]interface{}{"title": "Updated"}).
+		Add("GET", "/item/3", nil)
+
+	responses, err := batch.Execute(context.Background(), client)
+	r.NoError(err)
+	r.Len(responses, 3)
+
+	item := &Item{}
+	r.Equal(200, responses[0].StatusCode)
+	r.NoError(responses[0].Unmarshal(item))
+	r.Equal(int64(1), item.Id)
+	r.Equal("First", item.Title)
+
+	r.Equal(204, responses[1].StatusCode)
+	r.NoError(responses[1].Unmarshal(nil))
+
+	r.Equal(404, responses[2].StatusCode)
+	err = responses[2].Unmarshal(&Item{})
+	r.IsType(&Error{}, err)
+	r.True(errors.Is(err, ErrNotFound))
+	r.EqualError(err, "not_found: Object not found")
+}
+
+func TestBatchRequestLimits(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Error("no request expected")
+	})
+
+	_, err := (&BatchRequest{}).Execute(context.Background(), client)
+	r.Error(err)
+
+	batch := NewBatchRequest()
+	for i := 0; i <= maxBatchSize; i++ {
+		batch.Add("GET", "/item/1", nil)
+	}
+	_, err = batch.Execute(context.Background(), client)
+	r.EqualError(err, "podio: batch has 11 operations, at most 10 are allowed")
+}
+
+func TestBatchRequestFailure(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "Batch not allowed"}`))
+	})
+
+	_, err := NewBatchRequest().Add("GET", "/item/1", nil).Execute(context.Background(), client)
+	r.EqualError(err, "forbidden: Batch not allowed")
+}
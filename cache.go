@@ -0,0 +1,185 @@
+package podio
+
+import (
+	"sync"
+	"time"
+)
+
+// AppCache stores apps with their fields for GetAppWithFields. Implementations must be
+// safe for concurrent use.
+type AppCache interface {
+	// Get returns the cached app, and whether it was found
+	Get(appId int64) (*AppFull, bool)
+	Set(app *AppFull)
+	// Delete removes an app from the cache, e.g. because it or its fields were updated
+	Delete(appId int64)
+}
+
+// WithAppCache makes the client look up apps fetched with GetAppWithFields in cache
+// before requesting them from Podio. Apps changed through the client, including their
+// fields, are removed from the cache.
+func WithAppCache(cache AppCache) ClientOption {
+	return func(client *Client) {
+		client.appCache = cache
+	}
+}
+
+// ItemCache stores items for GetItemWithCache. Implementations must be safe for
+// concurrent use. The cached items are shared by all callers of GetItemWithCache, so
+// they must not be modified.
+type ItemCache interface {
+	// Get returns the cached item, and whether it was found
+	Get(itemId int64) (*Item, bool)
+	Set(item *Item)
+	// Delete removes an item from the cache, e.g. because it was updated
+	Delete(itemId int64)
+}
+
+// WithItemCache makes the client look up items fetched with GetItemWithCache in cache
+// before requesting them from Podio. Items updated through the client with UpdateItem,
+// or given files with AttachFile, are removed from the cache. Changes made otherwise,
+// e.g. by other clients or with Request, are only seen once the cached item expires.
+func WithItemCache(cache ItemCache) ClientOption {
+	return func(client *Client) {
+		client.itemCache = cache
+	}
+}
+
+// WithSpaceAppCache makes the client keep the apps returned by GetApps for each space
+// for ttl. Creating, cloning or deleting an app through the client clears the cached
+// apps of the affected spaces.
+func WithSpaceAppCache(ttl time.Duration) ClientOption {
+	return func(client *Client) {
+		cache := newTTLCache(ttl)
+		client.spaceAppCache = &cache
+	}
+}
+
+// WithOrgListCache makes the client keep the organizations returned by GetOrganizations
+// for ttl. Creating or updating an organization through the client clears the cache.
+func WithOrgListCache(ttl time.Duration) ClientOption {
+	return func(client *Client) {
+		cache := newTTLCache(ttl)
+		client.orgListCache = &cache
+	}
+}
+
+// WithSpaceListCache makes the client keep the spaces returned by GetSpaces for each
+// organization for ttl. Creating a space clears the cached spaces of its organization,
+// updating or deleting a space clears the spaces of all organizations.
+func WithSpaceListCache(ttl time.Duration) ClientOption {
+	return func(client *Client) {
+		cache := newTTLCache(ttl)
+		client.spaceListCache = &cache
+	}
+}
+
+// ttlCache holds values by id for a fixed time
+type ttlCache struct {
+	ttl time.Duration
+	now func() time.Time // replaced in tests
+
+	mu      sync.Mutex
+	entries map[int64]ttlEntry
+}
+
+type ttlEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newTTLCache(ttl time.Duration) ttlCache {
+	return ttlCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[int64]ttlEntry{},
+	}
+}
+
+func (c *ttlCache) get(id int64) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, id)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache) set(id int64, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[id] = ttlEntry{value: value, expires: c.now().Add(c.ttl)}
+}
+
+func (c *ttlCache) delete(id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, id)
+}
+
+func (c *ttlCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[int64]ttlEntry{}
+}
+
+// MemoryAppCache is an AppCache holding apps in memory for a fixed time
+type MemoryAppCache struct {
+	cache ttlCache
+}
+
+// NewMemoryAppCache returns an empty MemoryAppCache keeping apps for ttl
+func NewMemoryAppCache(ttl time.Duration) *MemoryAppCache {
+	return &MemoryAppCache{cache: newTTLCache(ttl)}
+}
+
+func (c *MemoryAppCache) Get(appId int64) (*AppFull, bool) {
+	app, ok := c.cache.get(appId)
+	if !ok {
+		return nil, false
+	}
+	return app.(*AppFull), true
+}
+
+func (c *MemoryAppCache) Set(app *AppFull) {
+	c.cache.set(app.Id, app)
+}
+
+func (c *MemoryAppCache) Delete(appId int64) {
+	c.cache.delete(appId)
+}
+
+// MemoryItemCache is an ItemCache holding items in memory for a fixed time
+type MemoryItemCache struct {
+	cache ttlCache
+}
+
+// NewMemoryItemCache returns an empty MemoryItemCache keeping items for ttl
+func NewMemoryItemCache(ttl time.Duration) *MemoryItemCache {
+	return &MemoryItemCache{cache: newTTLCache(ttl)}
+}
+
+func (c *MemoryItemCache) Get(itemId int64) (*Item, bool) {
+	item, ok := c.cache.get(itemId)
+	if !ok {
+		return nil, false
+	}
+	return item.(*Item), true
+}
+
+func (c *MemoryItemCache) Set(item *Item) {
+	c.cache.set(item.Id, item)
+}
+
+func (c *MemoryItemCache) Delete(itemId int64) {
+	c.cache.delete(itemId)
+}
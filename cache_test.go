@@ -0,0 +1,327 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAppWithFieldsCached(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		r.Equal("/app/18166054", req.URL.Path)
+		r.Equal("full", req.URL.Query().Get("view"))
+		w.Write([]byte(`{"app_id": 18166054, "name": "Tasks", "fields": [{"field_id": 142837427, "external_id": "status", "type": "category"}]}`))
+	}
+
+	cache := NewMemoryAppCache(time.Minute)
+	now := time.Date(2017, 3, 21, 23, 29, 7, 0, time.UTC)
+	cache.cache.now = func() time.Time { return now }
+
+	client := newTestClient(t, handler)
+	WithAppCache(cache)(client)
+
+	for i := 0; i < 3; i++ {
+		app, err := client.GetAppWithFields(context.Background(), 18166054)
+		r.NoError(err)
+		r.Equal("Tasks", app.Name)
+		r.Len(app.Fields, 1)
+		r.Equal("status", app.Fields[0].ExternalId)
+	}
+	r.Equal(1, requests)
+
+	// the cached app expires after the ttl
+	now = now.Add(time.Minute)
+	_, err := client.GetAppWithFields(context.Background(), 18166054)
+	r.NoError(err)
+	r.Equal(2, requests)
+}
+
+func TestGetAppWithFieldsCachedChanges(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method + " " + req.URL.Path {
+		case "GET /app/18166054":
+			w.Write([]byte(`{"app_id": 18166054, "name": "Tasks", "fields": [{"field_id": 142837427, "external_id": "status", "type": "category", "config": {"required": true}}]}`))
+		case "GET /app/18166054/field":
+			w.Write([]byte(`[{"field_id": 142837427, "external_id": "status", "type": "category", "config": {"required": true}}]`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	WithAppCache(NewMemoryAppCache(time.Minute))(client)
+
+	// modifying the returned app does not change the cached app
+	app, err := client.GetAppWithFields(context.Background(), 18166054)
+	r.NoError(err)
+	app.Name = "Changed"
+	app.Fields[0].Config.Required = false
+	app, err = client.GetAppWithFields(context.Background(), 18166054)
+	r.NoError(err)
+	r.Equal("Tasks", app.Name)
+	r.True(app.Fields[0].Config.Required)
+	app.Fields = nil
+	app, err = client.GetAppWithFields(context.Background(), 18166054)
+	r.NoError(err)
+	r.Len(app.Fields, 1)
+	r.Equal([]string{"GET /app/18166054"}, requests)
+
+	// changes to the app or its fields through the client drop it from the cache
+	ctx := context.Background()
+	changes := map[string]func() error{
+		"UpdateApp": func() error { return client.UpdateApp(ctx, 18166054, &UpdateAppRequest{Name: "Todo"}) },
+		"UpdateAppField": func() error {
+			return client.UpdateAppField(ctx, 18166054, 142837427, &AppFieldRequest{Config: map[string]interface{}{"label": "State"}})
+		},
+		"DeleteAppField":   func() error { return client.DeleteAppField(ctx, 18166054, 142837427) },
+		"SetFieldRequired": func() error { return client.SetFieldOptional(ctx, 18166054, "status") },
+		"RevertApp":        func() error { return client.RevertApp(ctx, 18166054, 2) },
+	}
+	for name, change := range changes {
+		r.NoError(change(), name)
+		requests = nil
+		_, err := client.GetAppWithFields(context.Background(), 18166054)
+		r.NoError(err)
+		r.Equal([]string{"GET /app/18166054"}, requests, name)
+	}
+}
+
+func TestGetAppWithFieldsUncached(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Write([]byte(`{"app_id": 18166054}`))
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetAppWithFields(context.Background(), 18166054)
+		r.NoError(err)
+	}
+	r.Equal(2, requests)
+}
+
+func TestGetItemWithCache(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "GET" {
+			w.Write([]byte(`{"item_id": 582709679, "title": "Config"}`))
+		}
+	})
+	WithItemCache(NewMemoryItemCache(time.Minute))(client)
+
+	for i := 0; i < 3; i++ {
+		item, err := client.GetItemWithCache(context.Background(), 582709679)
+		r.NoError(err)
+		r.Equal("Config", item.Title)
+	}
+	r.Equal([]string{"GET /item/582709679"}, requests)
+
+	// updating the item through the client drops it from the cache
+	r.NoError(client.UpdateItem(582709679, map[string]interface{}{"title": "New config"}))
+	_, err := client.GetItemWithCache(context.Background(), 582709679)
+	r.NoError(err)
+	r.Equal([]string{"GET /item/582709679", "PUT /item/582709679", "GET /item/582709679"}, requests)
+
+	// as does attaching a file to it
+	requests = nil
+	r.NoError(client.AttachFile(259217905, "item", 582709679))
+	_, err = client.GetItemWithCache(context.Background(), 582709679)
+	r.NoError(err)
+	r.Equal([]string{"POST /file/259217905/attach", "GET /item/582709679"}, requests)
+
+	// but not attaching a file to something else
+	requests = nil
+	r.NoError(client.AttachFile(259217905, "task", 582709679))
+	_, err = client.GetItemWithCache(context.Background(), 582709679)
+	r.NoError(err)
+	r.Equal([]string{"POST /file/259217905/attach"}, requests)
+}
+
+func TestMemoryItemCacheExpiry(t *testing.T) {
+	r := require.New(t)
+
+	cache := NewMemoryItemCache(time.Minute)
+	now := time.Date(2017, 3, 21, 23, 29, 7, 0, time.UTC)
+	cache.cache.now = func() time.Time { return now }
+
+	cache.Set(&Item{Id: 582709679})
+	_, ok := cache.Get(582709679)
+	r.True(ok)
+
+	now = now.Add(59 * time.Second)
+	_, ok = cache.Get(582709679)
+	r.True(ok)
+
+	now = now.Add(time.Second)
+	_, ok = cache.Get(582709679)
+	r.False(ok)
+
+	cache.Set(&Item{Id: 582709679})
+	cache.Delete(582709679)
+	_, ok = cache.Get(582709679)
+	r.False(ok)
+}
+
+func TestSpaceAppCache(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method + " " + req.URL.Path {
+		case "GET /app/space/1", "GET /app/space/2":
+			w.Write([]byte(`[{"app_id": 10, "name": "Leads"}]`))
+		case "POST /app/", "POST /app/10/install":
+			w.Write([]byte(`{"app_id": 11}`))
+		case "GET /app/11":
+			w.Write([]byte(`{"app_id": 11, "name": "Deals"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	WithSpaceAppCache(time.Minute)(client)
+
+	get := func(spaceId int64) {
+		apps, err := client.GetApps(spaceId)
+		r.NoError(err)
+		r.Equal([]App{{Id: 10, Name: "Leads"}}, apps)
+
+		// changing the result must not change the cache
+		apps[0].Name = "changed"
+	}
+
+	get(1)
+	get(1)
+	get(2)
+	r.Equal([]string{"GET /app/space/1", "GET /app/space/2"}, requests)
+
+	// creating an app only clears the space of the app
+	ctx := context.Background()
+	requests = nil
+	_, err := client.CreateApp(ctx, 1, &CreateAppRequest{Name: "Deals"})
+	r.NoError(err)
+	get(1)
+	get(2)
+	r.Equal([]string{"POST /app/", "GET /app/11", "GET /app/space/1"}, requests)
+
+	requests = nil
+	_, err = client.CloneApp(ctx, 10, 2)
+	r.NoError(err)
+	get(1)
+	get(2)
+	r.Equal([]string{"POST /app/10/install", "GET /app/11", "GET /app/space/2"}, requests)
+
+	// deleting an app clears all spaces
+	requests = nil
+	r.NoError(client.DeleteApp(ctx, 10))
+	get(1)
+	get(2)
+	r.Equal([]string{"DELETE /app/10", "GET /app/space/1", "GET /app/space/2"}, requests)
+}
+
+func TestOrgListCache(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method + " " + req.URL.Path {
+		case "GET /org":
+			w.Write([]byte(`[{"org_id": 736, "name": "Podio"}]`))
+		case "GET /org/736":
+			w.Write([]byte(`{"org_id": 736}`))
+		case "GET /org/737":
+			w.Write([]byte(`{"org_id": 737}`))
+		case "POST /org/":
+			w.Write([]byte(`{"org_id": 737}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	WithOrgListCache(time.Minute)(client)
+
+	get := func() {
+		orgs, err := client.GetOrganizations()
+		r.NoError(err)
+		r.Equal([]Organization{{Id: 736, Name: "Podio"}}, orgs)
+		orgs[0].Name = "changed"
+	}
+
+	get()
+	get()
+	r.Equal([]string{"GET /org"}, requests)
+
+	ctx := context.Background()
+	_, err := client.CreateOrganization(ctx, &CreateOrgRequest{Name: "Other"})
+	r.NoError(err)
+	get()
+	_, err = client.UpdateOrganization(ctx, 736, &UpdateOrgRequest{Name: "Podio"})
+	r.NoError(err)
+	get()
+	r.Equal([]string{
+		"GET /org",
+		"POST /org/", "GET /org/737", "GET /org",
+		"PUT /org/736", "GET /org/736", "GET /org",
+	}, requests)
+}
+
+func TestSpaceListCache(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method + " " + req.URL.Path {
+		case "GET /org/1/space", "GET /org/2/space":
+			w.Write([]byte(`[{"space_id": 10, "name": "Sales"}]`))
+		case "POST /space/":
+			w.Write([]byte(`{"space_id": 11}`))
+		case "GET /space/11":
+			w.Write([]byte(`{"space_id": 11, "name": "Marketing"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	WithSpaceListCache(time.Minute)(client)
+
+	get := func(orgId int64) {
+		spaces, err := client.GetSpaces(orgId)
+		r.NoError(err)
+		r.Equal([]Space{{Id: 10, Name: "Sales"}}, spaces)
+		spaces[0].Name = "changed"
+	}
+
+	get(1)
+	get(1)
+	get(2)
+	r.Equal([]string{"GET /org/1/space", "GET /org/2/space"}, requests)
+
+	// creating a space only clears its organization
+	ctx := context.Background()
+	requests = nil
+	_, err := client.CreateSpace(ctx, 1, &CreateSpaceRequest{Name: "Marketing"})
+	r.NoError(err)
+	get(1)
+	get(2)
+	r.Equal([]string{"POST /space/", "GET /space/11", "GET /org/1/space"}, requests)
+
+	requests = nil
+	r.NoError(client.DeleteSpace(ctx, 10))
+	get(1)
+	get(2)
+	r.Equal([]string{"DELETE /space/10", "GET /org/1/space", "GET /org/2/space"}, requests)
+}
@@ -0,0 +1,60 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// CalendarEvent is an entry in a calendar, such as an item with a date field or a task.
+// RefType and RefId identify the object the event comes from.
+type CalendarEvent struct {
+	UID         string `json:"uid"`
+	Type        string `json:"type"`
+	RefType     string `json:"ref_type"`
+	RefId       int64  `json:"ref_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Location    string `json:"location"`
+	StartOn     Time   `json:"start_utc"`
+	EndOn       *Time  `json:"end_utc"` // nil if the event has no end
+	Link        string `json:"link"`
+	App         *App   `json:"app"` // the app of item events
+}
+
+func calendarQuery(from, to time.Time) string {
+	values := url.Values{
+		"date_from": {from.Format("2006-01-02")},
+		"date_to":   {to.Format("2006-01-02")},
+	}
+	return "?" + values.Encode()
+}
+
+// GetGlobalCalendar returns the events of all calendars of the authenticated user between
+// the dates from and to, both included.
+//
+// https://developers.podio.com/doc/calendar/get-global-calendar-22458
+func (client *Client) GetGlobalCalendar(ctx context.Context, from, to time.Time) (events []*CalendarEvent, err error) {
+	err = client.requestContext(ctx, "GET", "/calendar/"+calendarQuery(from, to), nil, nil, &events)
+	return
+}
+
+// GetAppCalendar returns the events of the items in an app between the dates from and to,
+// both included.
+//
+// https://developers.podio.com/doc/calendar/get-app-calendar-22460
+func (client *Client) GetAppCalendar(ctx context.Context, appId int64, from, to time.Time) (events []*CalendarEvent, err error) {
+	path := fmt.Sprintf("/calendar/app/%d/", appId)
+	err = client.requestContext(ctx, "GET", path+calendarQuery(from, to), nil, nil, &events)
+	return
+}
+
+// GetSpaceCalendar returns the events in a space between the dates from and to, both included.
+//
+// https://developers.podio.com/doc/calendar/get-space-calendar-22459
+func (client *Client) GetSpaceCalendar(ctx context.Context, spaceId int64, from, to time.Time) (events []*CalendarEvent, err error) {
+	path := fmt.Sprintf("/calendar/space/%d/", spaceId)
+	err = client.requestContext(ctx, "GET", path+calendarQuery(from, to), nil, nil, &events)
+	return
+}
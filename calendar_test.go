@@ -0,0 +1,57 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCalendars(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{
+			"uid": "item_582709679_142837428",
+			"type": "item",
+			"ref_type": "item",
+			"ref_id": 582709679,
+			"title": "Kick-off",
+			"start_utc": "2017-03-24 09:00:00",
+			"end_utc": null,
+			"app": {"app_id": 18166054, "name": "Meetings"}
+		}]`))
+	})
+
+	ctx := context.Background()
+	from := time.Date(2017, 3, 20, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 3, 26, 0, 0, 0, 0, time.UTC)
+
+	events, err := client.GetGlobalCalendar(ctx, from, to)
+	r.NoError(err)
+	r.Len(events, 1)
+
+	event := events[0]
+	r.Equal("item", event.RefType)
+	r.Equal(int64(582709679), event.RefId)
+	r.Equal("Kick-off", event.Title)
+	r.Equal(*parseTime(t, "2017-03-24 09:00:00"), event.StartOn)
+	r.Nil(event.EndOn)
+	r.Equal(int64(18166054), event.App.Id)
+
+	_, err = client.GetAppCalendar(ctx, 18166054, from, to)
+	r.NoError(err)
+	_, err = client.GetSpaceCalendar(ctx, 2720177, from, to)
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/calendar/?date_from=2017-03-20&date_to=2017-03-26",
+		"/calendar/app/18166054/?date_from=2017-03-20&date_to=2017-03-26",
+		"/calendar/space/2720177/?date_from=2017-03-20&date_to=2017-03-26",
+	}, urls)
+}
@@ -2,17 +2,74 @@ package podio
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"sync"
+	"time"
 )
 
+//go:generate go run gen_client.go
+
+const defaultBaseURL = "https://api.podio.com"
+
 type Client struct {
 	httpClient *http.Client
 	authToken  *AuthToken
+	baseURL    string
+
+	// id of the authenticated user, cached by GetCurrentUserID
+	userIdMu sync.Mutex
+	userId   int64
+
+	appCache       AppCache  // set by WithAppCache
+	itemCache      ItemCache // set by WithItemCache
+	spaceAppCache  *ttlCache // apps by space id, set by WithSpaceAppCache
+	orgListCache   *ttlCache // the organizations under id 0, set by WithOrgListCache
+	spaceListCache *ttlCache // spaces by org id, set by WithSpaceListCache
+}
+
+// ClientOption configures optional settings on a Client created with NewClient.
+type ClientOption func(*Client)
+
+// WithTransport makes the client use the given transport for all API requests.
+// Sharing a single transport between clients lets them share a connection pool.
+func WithTransport(transport *http.Transport) ClientOption {
+	return func(client *Client) {
+		client.httpClient.Transport = transport
+	}
+}
+
+// WithRoundTripper makes the client send all API requests through transport, e.g.
+// a podiotest.CassetteTransport. Use WithTransport to configure an *http.Transport.
+func WithRoundTripper(transport http.RoundTripper) ClientOption {
+	return func(client *Client) {
+		client.httpClient.Transport = transport
+	}
+}
+
+// WithBaseURL makes the client send its requests to another API root than
+// https://api.podio.com, e.g. a podiotest.Server. url must not end in a slash.
+func WithBaseURL(url string) ClientOption {
+	return func(client *Client) {
+		client.baseURL = url
+	}
+}
+
+// NewDefaultTransport returns a transport with connection pooling settings suited
+// for making many concurrent requests against the Podio API.
+func NewDefaultTransport() *http.Transport {
+	return &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 100,
+		MaxConnsPerHost:     100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
 }
 
 type Error struct {
@@ -25,29 +82,40 @@ type Error struct {
 	} `json:"request"`
 	Description string `json:"error_description"`
 	Type        string `json:"error"`
+
+	// HTTPStatusCode is the status of the response the error was read from. It is 0 for
+	// errors that were not returned by Podio, such as the sentinel errors.
+	HTTPStatusCode int `json:"-"`
+
+	// retryAfter is the wait given by the Retry-After header of the response
+	retryAfter time.Duration
 }
 
 func (p *Error) Error() string {
 	return fmt.Sprintf("%s: %s", p.Type, p.Description)
 }
 
-func NewClient(authToken *AuthToken) *Client {
-	return &Client{
+func NewClient(authToken *AuthToken, options ...ClientOption) *Client {
+	client := &Client{
 		httpClient: &http.Client{},
 		authToken:  authToken,
+		baseURL:    defaultBaseURL,
 	}
-}
 
-func (client *Client) Request(method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
-	req, err := http.NewRequest(method, "https://api.podio.com"+path, body)
-	if err != nil {
-		return err
+	for _, option := range options {
+		option(client)
 	}
 
-	for k, v := range headers {
-		req.Header.Add(k, v)
-	}
+	return client
+}
+
+func (client *Client) Request(method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
+	return client.requestContext(context.Background(), method, path, headers, body, out)
+}
 
+// do sends an API request and decodes the response into out. If Podio responds with
+// an error, it is returned as an *Error.
+func (client *Client) do(req *http.Request, out interface{}) error {
 	req.Header.Add("Authorization", "OAuth2 "+client.authToken.AccessToken)
 	resp, err := client.httpClient.Do(req)
 	if err != nil {
@@ -61,12 +129,7 @@ func (client *Client) Request(method string, path string, headers map[string]str
 	}
 
 	if !(200 <= resp.StatusCode && resp.StatusCode < 300) {
-		podioErr := &Error{}
-		err := json.Unmarshal(respBody, podioErr)
-		if err != nil {
-			return errors.New(string(respBody))
-		}
-		return podioErr
+		return newResponseError(resp.StatusCode, resp.Header, respBody)
 	}
 
 	if out != nil {
@@ -84,3 +147,27 @@ func (client *Client) RequestWithParams(method string, path string, headers map[
 
 	return client.Request(method, path, headers, bytes.NewReader(buf), out)
 }
+
+// requestContext is Request, but the request is aborted when ctx is cancelled
+func (client *Client) requestContext(ctx context.Context, method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
+	req, err := http.NewRequest(method, client.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range headers {
+		req.Header.Add(k, v)
+	}
+
+	return client.do(req.WithContext(ctx), out)
+}
+
+// requestWithParamsContext is RequestWithParams, but the request is aborted when ctx is cancelled
+func (client *Client) requestWithParamsContext(ctx context.Context, method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error {
+	buf, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	return client.requestContext(ctx, method, path, headers, bytes.NewReader(buf), out)
+}
@@ -0,0 +1,244 @@
+// Code generated by gen_client.go; DO NOT EDIT.
+
+package podio
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ClientInterface declares the exported methods of *Client, so code using a client
+// can be given a test double such as podiotest.RecordingClient instead.
+type ClientInterface interface {
+	// app.go
+	GetFieldOptions(ctx context.Context, appId int64, externalId string) ([]CategoryOption, error)
+	SetFieldRequired(ctx context.Context, appId int64, externalId string, required bool) error
+	SetFieldOptional(ctx context.Context, appId int64, externalId string) error
+	AddCategoryOption(ctx context.Context, appId int64, externalId string, text string, color string) (*CategoryOption, error)
+	RemoveCategoryOption(ctx context.Context, appId int64, externalId string, optionId int) error
+	GetAppWithFields(ctx context.Context, appId int64) (*AppFull, error)
+	GetApps(spaceId int64) ([]App, error)
+	GetApp(id int64) (*App, error)
+	GetAppView(ctx context.Context, id int64, view string) (*App, error)
+	GetAuthenticatedApp(ctx context.Context) (*App, error)
+	GetAppBySpaceIdAndSlug(spaceId int64, slug string) (*App, error)
+	GetAppFields(ctx context.Context, appId int64) ([]*AppField, error)
+	GetAppField(ctx context.Context, appId int64, fieldId int64) (*AppField, error)
+	CreateAppField(ctx context.Context, appId int64, req *AppFieldRequest) (int64, error)
+	UpdateAppField(ctx context.Context, appId int64, fieldId int64, req *AppFieldRequest) error
+	DeleteAppField(ctx context.Context, appId int64, fieldId int64) error
+	CreateApp(ctx context.Context, spaceId int64, req *CreateAppRequest) (*App, error)
+	UpdateApp(ctx context.Context, appId int64, req *UpdateAppRequest) error
+	DeleteApp(ctx context.Context, appId int64) error
+	CloneApp(ctx context.Context, appId int64, targetSpaceId int64) (*App, error)
+	GetAppRevisions(ctx context.Context, appId int64) ([]*AppRevision, error)
+	GetAppRevision(ctx context.Context, appId int64, revision int) (*AppRevision, error)
+	RevertApp(ctx context.Context, appId int64, revision int) error
+	ActivateApp(ctx context.Context, appId int64) error
+	DeactivateApp(ctx context.Context, appId int64) error
+
+	// calendar.go
+	GetGlobalCalendar(ctx context.Context, from time.Time, to time.Time) ([]*CalendarEvent, error)
+	GetAppCalendar(ctx context.Context, appId int64, from time.Time, to time.Time) ([]*CalendarEvent, error)
+	GetSpaceCalendar(ctx context.Context, spaceId int64, from time.Time, to time.Time) ([]*CalendarEvent, error)
+
+	// client.go
+	Request(method string, path string, headers map[string]string, body io.Reader, out interface{}) error
+	RequestWithParams(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error
+
+	// comment.go
+	Comment(refType string, refId int64, text string, params map[string]interface{}) (*Comment, error)
+	CreateCommentWithFiles(ctx context.Context, refType string, refId int64, text string, fileIds []int64) (*Comment, error)
+	GetComments(refType string, refId int64) ([]*Comment, error)
+	UpdateComment(ctx context.Context, commentId int64, text string) error
+	DeleteComment(ctx context.Context, commentId int64) error
+	GetComment(ctx context.Context, commentId int64) (*Comment, error)
+	LikeComment(ctx context.Context, commentId int64) error
+	UnlikeComment(ctx context.Context, commentId int64) error
+	GetCommentLikeCount(ctx context.Context, commentId int64) (int, error)
+	GetItemCommenters(ctx context.Context, itemId int64) ([]*Profile, error)
+
+	// contact.go
+	GetPersonalConnections(ctx context.Context) ([]*Profile, error)
+	GetOrgContacts(ctx context.Context, orgId int64, opts *ContactListOptions) ([]*Contact, error)
+	GetSpaceContacts(ctx context.Context, spaceId int64, opts *ContactListOptions) ([]*Contact, error)
+
+	// conversation.go
+	GetConversations(ctx context.Context, opts *ConversationListOptions) ([]*Conversation, error)
+	GetConversation(ctx context.Context, conversationId int64) (*Conversation, error)
+	CreateConversation(ctx context.Context, req *CreateConversationRequest) (*Conversation, error)
+	ReplyToConversation(ctx context.Context, conversationId int64, text string, fileIds []int64) error
+	GetConversationMessages(ctx context.Context, conversationId int64, opts *MessageListOptions) ([]*ConversationMessage, error)
+	AddConversationParticipant(ctx context.Context, conversationId int64, profileId int64) error
+
+	// embed.go
+	CreateEmbed(ctx context.Context, url string) (*Embed, error)
+	GetEmbed(ctx context.Context, embedId int64) (*Embed, error)
+
+	// file.go
+	GetFiles() ([]File, error)
+	GetFilesWithOptions(ctx context.Context, opts *FileListOptions) ([]*File, error)
+	GetFilesByItem(ctx context.Context, itemId int64, opts *FileListOptions) ([]*File, error)
+	GetFilesByApp(ctx context.Context, appId int64, opts *FileListOptions) ([]*File, error)
+	GetFilesBySpace(ctx context.Context, spaceId int64, opts *FileListOptions) ([]*File, error)
+	GetFile(fileId int) (*File, error)
+	GetFileContents(url string) ([]byte, error)
+	DownloadFileAsReader(ctx context.Context, url string) (io.ReadCloser, error)
+	CreateFile(name string, contents []byte) (*File, error)
+	CreateFileFromReader(ctx context.Context, name string, size int64, r io.Reader) (*File, error)
+	ReplaceFile(oldFileId int, newFileId int) error
+	AttachFile(fileId int, refType string, refId int) error
+	DeleteFile(fileId int) error
+
+	// form.go
+	GetForms(ctx context.Context, appId int64) ([]*Form, error)
+	GetForm(ctx context.Context, formId int64) (*Form, error)
+	ActivateForm(ctx context.Context, formId int64) error
+	DeactivateForm(ctx context.Context, formId int64) error
+
+	// grant.go
+	GetGrants(ctx context.Context, refType string, refId int64) ([]*Grant, error)
+	CreateGrant(ctx context.Context, refType string, refId int64, req *GrantRequest) error
+	RevokeGrant(ctx context.Context, refType string, refId int64, userId int64) error
+
+	// hook.go
+	GetHooks(ctx context.Context, refType string, refId int64) ([]*Hook, error)
+	CreateHook(ctx context.Context, refType string, refId int64, req *CreateHookRequest) (*Hook, error)
+	DeleteHook(ctx context.Context, hookId int64) error
+	VerifyHook(ctx context.Context, hookId int64, code string) error
+
+	// item.go
+	GetItems(appId int64) (*ItemList, error)
+	FilterItems(appId int64, params map[string]interface{}) (*ItemList, error)
+	GetItemsSorted(ctx context.Context, appId int64, sortBy string, sortDesc bool, limit int, offset int) (*ItemList, error)
+	GetItemsByPriority(ctx context.Context, appId int64, priorityExternalId string, ascending bool, limit int) (*ItemList, error)
+	GetItemsGroupedByCategory(ctx context.Context, appId int64, categoryExternalId string) (map[string][]*Item, error)
+	GetItemsWithView(ctx context.Context, appId int64, params map[string]interface{}, view string) (*ItemList, error)
+	FilterItemsByView(ctx context.Context, appId int64, viewId int64, params map[string]interface{}) (*ItemList, error)
+	GetItemCount(ctx context.Context, appId int64) (int, error)
+	GetItemCountsBySpace(ctx context.Context, spaceId int64) (map[int64]int, error)
+	GetItemsAssignedToUser(ctx context.Context, appId int64, profileId int64) (*ItemList, error)
+	AllItems(ctx context.Context, appId int64, params map[string]interface{}) ([]*Item, error)
+	AllViewItemsFull(ctx context.Context, appId int64, viewId int64) ([]*Item, error)
+	GetItemsAsChannel(ctx context.Context, appId int64, filter *ItemFilterRequest) (<-chan *Item, <-chan error)
+	GetItemByAppItemId(appId int64, formattedAppItemId string) (*Item, error)
+	GetItemByExternalID(appId int64, externalId string) (*Item, error)
+	GetItem(itemId int64) (*Item, error)
+	GetItemWithCache(ctx context.Context, itemId int64) (*Item, error)
+	GetItemCreator(ctx context.Context, itemId int64) (*ByLine, Time, error)
+	GetItemLastEditor(ctx context.Context, itemId int64) (*ByLine, Time, error)
+	GetItemImages(ctx context.Context, itemId int64) ([]*File, error)
+	CreateItem(appId int, externalId string, fieldValues map[string]interface{}) (int64, error)
+	UpdateItem(itemId int, fieldValues map[string]interface{}) error
+
+	// notification.go
+	GetNotifications(ctx context.Context, opts *NotificationListOptions) ([]*Notification, error)
+	MarkNotificationRead(ctx context.Context, notificationId int64) error
+	MarkAllNotificationsRead(ctx context.Context) error
+	GetPersonalNotificationCount(ctx context.Context) (int, error)
+	GetUnreadNotificationCount(ctx context.Context) (int, error)
+	GetNotificationCount(ctx context.Context) (*NotificationCount, error)
+
+	// org.go
+	GetOrganizations() ([]Organization, error)
+	GetOrganization(id int64) (*Organization, error)
+	GetOrganizationBySlug(slug string) (*Organization, error)
+	CreateOrganization(ctx context.Context, req *CreateOrgRequest) (*Organization, error)
+	UpdateOrganization(ctx context.Context, orgId int64, req *UpdateOrgRequest) (*Organization, error)
+	GetOrgMembers(ctx context.Context, orgId int64) ([]*OrgMember, error)
+	InviteToOrganization(ctx context.Context, orgId int64, mails []string, role MemberRole) error
+	RemoveOrgMember(ctx context.Context, orgId int64, userId int64) error
+	GetOrganizationQuota(ctx context.Context, orgId int64) (*OrganizationQuota, error)
+	GetQuotaRemaining(ctx context.Context, orgId int64) (float64, error)
+	GetAppsGroupedBySpace(ctx context.Context, orgId int64) (map[int64][]App, error)
+	GetOrgHierarchy(ctx context.Context, orgId int64) (*OrgHierarchy, error)
+	GetAllOrganizationItems(ctx context.Context, orgId int64, filter *ItemFilterRequest) ([]*Item, error)
+
+	// rating.go
+	GetRatings(ctx context.Context, refType string, refId int64, ratingType string) (*RatingSummary, error)
+	CreateRating(ctx context.Context, refType string, refId int64, ratingType string, value int) error
+	DeleteRating(ctx context.Context, refType string, refId int64, ratingType string) error
+
+	// reminder.go
+	GetReminder(ctx context.Context, refType string, refId int64) (*Reminder, error)
+	CreateReminder(ctx context.Context, refType string, refId int64, remindDelta int) error
+	UpdateReminder(ctx context.Context, refType string, refId int64, remindDelta int) error
+	DeleteReminder(ctx context.Context, refType string, refId int64) error
+
+	// search.go
+	GlobalSearch(ctx context.Context, query string, opts *SearchOptions) (*SearchResult, error)
+	SearchItems(ctx context.Context, query string, appId int64, opts *SearchOptions) (*SearchResult, error)
+	SearchApps(ctx context.Context, query string, orgId int64) ([]*App, error)
+	SearchSpaces(ctx context.Context, query string, orgId int64) ([]*Space, error)
+
+	// space.go
+	GetSpaces(orgId int64) ([]Space, error)
+	GetSpace(id int64) (*Space, error)
+	GetSpaceByOrgIdAndSlug(orgId int64, slug string) (*Space, error)
+	CreateSpace(ctx context.Context, orgId int64, req *CreateSpaceRequest) (*Space, error)
+	UpdateSpace(ctx context.Context, spaceId int64, req *UpdateSpaceRequest) (*Space, error)
+	DeleteSpace(ctx context.Context, spaceId int64) error
+	GetSpaceMembers(ctx context.Context, spaceId int64) ([]*SpaceMember, error)
+	GetActiveMembersInSpace(ctx context.Context, spaceId int64, since time.Time) ([]*SpaceMember, error)
+	InviteToSpace(ctx context.Context, spaceId int64, req *SpaceInviteRequest) error
+	RemoveSpaceMember(ctx context.Context, spaceId int64, userId int64) error
+	UpdateSpaceMember(ctx context.Context, spaceId int64, userId int64, role MemberRole) error
+	GetSpaceApplications(ctx context.Context, spaceId int64) ([]Application, error)
+	AcceptSpaceApplication(ctx context.Context, spaceId int64, applicationId int64) error
+
+	// stream.go
+	GetSpaceActivity(ctx context.Context, spaceId int64, limit int, offset int) ([]*Activity, error)
+	GetItemActivityCount(ctx context.Context, itemId int64) (int, error)
+	GetOrgActivity(ctx context.Context, orgId int64, limit int, offset int) ([]*Activity, error)
+	GetOrganizationRecentItems(ctx context.Context, orgId int64, limit int) ([]*Item, error)
+
+	// subscription.go
+	Subscribe(ctx context.Context, refType string, refId int64) error
+	Unsubscribe(ctx context.Context, refType string, refId int64) error
+	StopWatchingItem(ctx context.Context, itemId int64) error
+	GetItemSubscribers(ctx context.Context, itemId int64) ([]*Profile, error)
+	GetTaskSubscribers(ctx context.Context, taskId int64) ([]*Profile, error)
+
+	// task.go
+	GetTasks(ctx context.Context, opts *TaskListOptions) ([]*Task, error)
+	AllTasks(ctx context.Context, opts *TaskListOptions) ([]*Task, error)
+	GetMyUpcomingTasks(ctx context.Context, withinDays int) ([]*Task, error)
+	GetRecurringTasks(ctx context.Context) ([]*Task, error)
+	GetCompletedTasksByUser(ctx context.Context, userId int64, from time.Time, to time.Time) ([]*Task, error)
+	GetAverageTaskCompletionTime(ctx context.Context, appId int64, from time.Time, to time.Time) (time.Duration, error)
+	GetTasksForRef(ctx context.Context, refType string, refId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTasksForItem(ctx context.Context, itemId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTasksByItem(ctx context.Context, itemId int64) ([]*Task, error)
+	GetTasksByApp(ctx context.Context, appId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTasksBySpace(ctx context.Context, spaceId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTasksByOrg(ctx context.Context, orgId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTasksForApp(ctx context.Context, appId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTasksForSpace(ctx context.Context, spaceId int64, opts *TaskListOptions) ([]*Task, error)
+	GetTask(ctx context.Context, taskId int64) (*Task, error)
+	CreateTask(ctx context.Context, req *CreateTaskRequest) (*Task, error)
+	UpdateTask(ctx context.Context, taskId int64, req *UpdateTaskRequest) error
+	DeleteTask(ctx context.Context, taskId int64) error
+	CompleteTask(ctx context.Context, taskId int64) error
+	UncompleteTask(ctx context.Context, taskId int64) error
+	AssignTask(ctx context.Context, taskId int64, profileId int64) error
+	GetTaskLabels(ctx context.Context) ([]*TaskLabel, error)
+	CreateTaskLabel(ctx context.Context, text string, color string) (*TaskLabel, error)
+	UpdateTaskLabel(ctx context.Context, labelId int64, text string, color string) error
+	DeleteTaskLabel(ctx context.Context, labelId int64) error
+
+	// user.go
+	GetCurrentUser(ctx context.Context) (*User, error)
+	GetUserProfile(ctx context.Context, userId int64) (*Profile, error)
+	UpdateUserProfile(ctx context.Context, req *UpdateProfileRequest) error
+	GetCurrentUserID(ctx context.Context) (int64, error)
+
+	// view.go
+	GetAppViews(ctx context.Context, appId int64) ([]*AppView, error)
+	GetView(ctx context.Context, appId int64, viewId int64) (*AppView, error)
+	CreateAppView(ctx context.Context, appId int64, req *AppViewRequest) (int64, error)
+	UpdateAppView(ctx context.Context, viewId int64, req *AppViewRequest) error
+	DeleteAppView(ctx context.Context, viewId int64) error
+}
+
+var _ ClientInterface = (*Client)(nil)
@@ -0,0 +1,120 @@
+package podio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client whose requests are served by handler instead of
+// the Podio API.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(&AuthToken{AccessToken: "token"})
+	client.baseURL = server.URL
+	return client
+}
+
+func TestWithTransport(t *testing.T) {
+	r := require.New(t)
+
+	// clients given the same transport share its connection pool
+	transport := NewDefaultTransport()
+	first := NewClient(&AuthToken{}, WithTransport(transport))
+	second := NewClient(&AuthToken{}, WithTransport(transport))
+	r.True(first.httpClient.Transport == transport)
+	r.True(second.httpClient.Transport == transport)
+
+	// without the option the client uses the default transport of net/http
+	r.Nil(NewClient(&AuthToken{}).httpClient.Transport)
+}
+
+func TestNewDefaultTransport(t *testing.T) {
+	r := require.New(t)
+
+	transport := NewDefaultTransport()
+	r.Equal(100, transport.MaxIdleConns)
+	r.Equal(100, transport.MaxIdleConnsPerHost)
+	r.Equal(100, transport.MaxConnsPerHost)
+	r.Equal(90*time.Second, transport.IdleConnTimeout)
+	r.NotNil(transport.Proxy)
+
+	// each call returns a new transport with its own connection pool
+	r.False(NewDefaultTransport() == transport)
+}
+
+func TestWithRoundTripper(t *testing.T) {
+	r := require.New(t)
+
+	var transport http.RoundTripper = NewDefaultTransport()
+	client := NewClient(&AuthToken{}, WithRoundTripper(transport))
+	r.Equal(transport, client.httpClient.Transport)
+}
+
+func TestWithBaseURL(t *testing.T) {
+	r := require.New(t)
+
+	client := NewClient(&AuthToken{}, WithBaseURL("http://localhost:8080"))
+	r.Equal("http://localhost:8080", client.baseURL)
+	r.Equal(defaultBaseURL, NewClient(&AuthToken{}).baseURL)
+}
+
+func TestRequestSendsAuthorization(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("OAuth2 token", req.Header.Get("Authorization"))
+		w.Write([]byte(`{}`))
+	})
+
+	err := client.Request("GET", "/org", nil, nil, nil)
+	r.NoError(err)
+}
+
+// TestPointerResults checks that methods returning a pointer decode the response into it.
+func TestPointerResults(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/item/582709679":
+			w.Write([]byte(`{"item_id": 582709679}`))
+		case "/app/18166054":
+			w.Write([]byte(`{"app_id": 18166054}`))
+		case "/space/2720177":
+			w.Write([]byte(`{"space_id": 2720177}`))
+		case "/org/736":
+			w.Write([]byte(`{"org_id": 736}`))
+		case "/file/195174666":
+			w.Write([]byte(`{"file_id": 195174666}`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	item, err := client.GetItem(582709679)
+	r.NoError(err)
+	r.Equal(int64(582709679), item.Id)
+
+	app, err := client.GetApp(18166054)
+	r.NoError(err)
+	r.Equal(int64(18166054), app.Id)
+
+	space, err := client.GetSpace(2720177)
+	r.NoError(err)
+	r.Equal(int64(2720177), space.Id)
+
+	org, err := client.GetOrganization(736)
+	r.NoError(err)
+	r.Equal(int64(736), org.Id)
+
+	file, err := client.GetFile(195174666)
+	r.NoError(err)
+	r.Equal(int64(195174666), file.Id)
+}
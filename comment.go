@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Comment is a comment on an object in podio.
 // The object to which this comment is associated is described in this Reference.
@@ -23,6 +26,11 @@ type Comment struct {
 // text is the actual comment value.
 // Additional parameters can be set in the params map.
 func (client *Client) Comment(refType string, refId int64, text string, params map[string]interface{}) (*Comment, error) {
+	return client.commentContext(context.Background(), refType, refId, text, params)
+}
+
+// commentContext is Comment, but the request is aborted when ctx is cancelled
+func (client *Client) commentContext(ctx context.Context, refType string, refId int64, text string, params map[string]interface{}) (*Comment, error) {
 	path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
 	if params == nil {
 		params = map[string]interface{}{}
@@ -30,16 +38,112 @@ func (client *Client) Comment(refType string, refId int64, text string, params m
 	params["value"] = text
 
 	comment := &Comment{}
-	err := client.RequestWithParams("POST", path, nil, params, comment)
+	err := client.requestWithParamsContext(ctx, "POST", path, nil, params, comment)
 	return comment, err
 }
 
+// CreateCommentWithFiles adds a comment with attached files to a podio object.
+// The files must have been uploaded beforehand, e.g. with CreateFile.
+func (client *Client) CreateCommentWithFiles(ctx context.Context, refType string, refId int64, text string, fileIds []int64) (*Comment, error) {
+	params := map[string]interface{}{
+		"file_ids": fileIds,
+	}
+	return client.commentContext(ctx, refType, refId, text, params)
+}
+
 // GetComments retrieves the comments associated with a podio object.
 //
 // refType is the type of the podio object. For legal type values see
 // refId is the podio id of the podio object.
 func (client *Client) GetComments(refType string, refId int64) (comments []*Comment, err error) {
+	return client.getCommentsContext(context.Background(), refType, refId)
+}
+
+// getCommentsContext is GetComments, but the request is aborted when ctx is cancelled
+func (client *Client) getCommentsContext(ctx context.Context, refType string, refId int64) (comments []*Comment, err error) {
 	path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
-	err = client.Request("GET", path, nil, nil, &comments)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &comments)
 	return
 }
+
+// UpdateComment replaces the text of a comment.
+//
+// https://developers.podio.com/doc/comments/update-a-comment-22346
+func (client *Client) UpdateComment(ctx context.Context, commentId int64, text string) error {
+	path := fmt.Sprintf("/comment/%d", commentId)
+	params := map[string]interface{}{
+		"value": text,
+	}
+
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, params, nil)
+}
+
+// DeleteComment deletes a comment.
+//
+// https://developers.podio.com/doc/comments/delete-a-comment-22347
+func (client *Client) DeleteComment(ctx context.Context, commentId int64) error {
+	path := fmt.Sprintf("/comment/%d", commentId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/comments/get-a-comment-22345
+func (client *Client) GetComment(ctx context.Context, commentId int64) (comment *Comment, err error) {
+	path := fmt.Sprintf("/comment/%d", commentId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &comment)
+	return
+}
+
+// LikeComment likes a comment on behalf of the user.
+func (client *Client) LikeComment(ctx context.Context, commentId int64) error {
+	path := fmt.Sprintf("/comment/%d/like", commentId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// UnlikeComment removes the like of the user from a comment.
+func (client *Client) UnlikeComment(ctx context.Context, commentId int64) error {
+	path := fmt.Sprintf("/comment/%d/like", commentId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// GetCommentLikeCount returns the number of likes of a comment.
+func (client *Client) GetCommentLikeCount(ctx context.Context, commentId int64) (int, error) {
+	comment, err := client.GetComment(ctx, commentId)
+	if err != nil {
+		return 0, err
+	}
+	return comment.LikeCount, nil
+}
+
+// GetItemCommenters returns the profiles of the distinct users who have commented on an
+// item, in the order of their first comment. Comments created by apps are left out, as
+// apps have no profile.
+//
+// A comment only holds the user id of its creator, so the profiles are fetched with one
+// request per user.
+func (client *Client) GetItemCommenters(ctx context.Context, itemId int64) ([]*Profile, error) {
+	comments, err := client.getCommentsContext(ctx, "item", itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[int64]bool{}
+	userIds := []int64{}
+	for _, comment := range comments {
+		if comment.CreatedBy.Type != "user" || seen[comment.CreatedBy.Id] {
+			continue
+		}
+		seen[comment.CreatedBy.Id] = true
+		userIds = append(userIds, comment.CreatedBy.Id)
+	}
+
+	profiles := make([]*Profile, len(userIds))
+	err = forEachConcurrently(len(userIds), func(i int) error {
+		profile, err := client.GetUserProfile(ctx, userIds[i])
+		profiles[i] = profile
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
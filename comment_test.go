@@ -0,0 +1,168 @@
+package podio
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAndDeleteComment(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.UpdateComment(context.Background(), 475900923, "edited"))
+	r.NoError(client.DeleteComment(context.Background(), 475900923))
+	r.Equal([]string{
+		`PUT /comment/475900923 {"value":"edited"}`,
+		`DELETE /comment/475900923 `,
+	}, requests)
+}
+
+// Comment decodes the created comment into the returned *Comment; this guards
+// against regressing on how the result is passed to the request.
+func TestCommentReturnsCreatedComment(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/comment/item/582709679/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"value": "hello", "external_id": "ext"}`, string(body))
+
+		w.Write([]byte(`{"comment_id": 475900923, "value": "hello", "external_id": "ext", "created_on": "2017-03-21 23:29:07"}`))
+	})
+
+	comment, err := client.Comment("item", 582709679, "hello", map[string]interface{}{"external_id": "ext"})
+	r.NoError(err)
+	r.NotNil(comment)
+	r.Equal(int64(475900923), comment.Id)
+	r.Equal("hello", comment.Value)
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), comment.CreatedOn)
+}
+
+func TestLikeComment(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method {
+		case "GET":
+			w.Write([]byte(`{"comment_id": 475900923, "is_liked": true, "like_count": 3}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "not_found", "error_description": "Object not found"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	r.NoError(client.LikeComment(context.Background(), 475900923))
+
+	count, err := client.GetCommentLikeCount(context.Background(), 475900923)
+	r.NoError(err)
+	r.Equal(3, count)
+
+	err = client.UnlikeComment(context.Background(), 475900923)
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected error to be *podio.Error, is %#v", err)
+	r.Equal("not_found", podioErr.Type)
+
+	r.Equal([]string{
+		"POST /comment/475900923/like",
+		"GET /comment/475900923",
+		"DELETE /comment/475900923/like",
+	}, requests)
+}
+
+func TestCreateCommentWithFiles(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/comment/item/582709679/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"value": "see attached", "file_ids": [195174666, 231335541]}`, string(body))
+
+		w.Write([]byte(`{"comment_id": 475900924, "files": [{"file_id": 195174666}, {"file_id": 231335541}]}`))
+	})
+
+	comment, err := client.CreateCommentWithFiles(context.Background(), "item", 582709679, "see attached", []int64{195174666, 231335541})
+	r.NoError(err)
+	r.Equal(int64(475900924), comment.Id)
+	r.Len(comment.Files, 2)
+}
+
+func TestGetItemCommenters(t *testing.T) {
+	r := require.New(t)
+
+	var profiles []string
+	var mu sync.Mutex
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/comment/item/582709679/":
+			w.Write([]byte(`[
+				{"comment_id": 1, "created_by": {"type": "user", "id": 2468975, "name": "Brian Stengaard"}},
+				{"comment_id": 2, "created_by": {"type": "app", "id": 2468975, "name": "Some app"}},
+				{"comment_id": 3, "created_by": {"type": "user", "id": 2468975, "name": "Brian Stengaard"}},
+				{"comment_id": 4, "created_by": {"type": "user", "id": 4587, "name": "Andreas"}}
+			]`))
+		case "/contact/user/2468975":
+			mu.Lock()
+			profiles = append(profiles, req.URL.Path)
+			mu.Unlock()
+			w.Write([]byte(`{"user_id": 2468975, "profile_id": 140798621, "name": "Brian Stengaard"}`))
+		case "/contact/user/4587":
+			mu.Lock()
+			profiles = append(profiles, req.URL.Path)
+			mu.Unlock()
+			w.Write([]byte(`{"user_id": 4587, "profile_id": 9205, "name": "Andreas"}`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	commenters, err := client.GetItemCommenters(context.Background(), 582709679)
+	r.NoError(err)
+	r.Equal([]*Profile{
+		{UserId: 2468975, ProfileId: 140798621, Name: "Brian Stengaard"},
+		{UserId: 4587, ProfileId: 9205, Name: "Andreas"},
+	}, commenters)
+	// the profiles are fetched concurrently, in any order
+	sort.Strings(profiles)
+	r.Equal([]string{"/contact/user/2468975", "/contact/user/4587"}, profiles)
+}
+
+func TestGetItemCommentersProfileError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/comment/item/582709679/" {
+			w.Write([]byte(`[{"comment_id": 1, "created_by": {"type": "user", "id": 2468975}}]`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "not_found", "error_description": "Object not found"}`))
+	})
+
+	commenters, err := client.GetItemCommenters(context.Background(), 582709679)
+	r.Nil(commenters)
+	r.True(errors.Is(err, ErrNotFound), "Expected ErrNotFound, got %v", err)
+}
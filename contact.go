@@ -1,5 +1,12 @@
 package podio
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
 // Contact describes a Podio contact object
 type Contact struct {
 	UserId     int    `json:"user_id"`
@@ -13,3 +20,63 @@ type Contact struct {
 	LastSeenOn *Time  `json:"last_seen_on"`
 	Name       string `json:"name"`
 }
+
+// Profile is the profile of a Podio user, as returned when listing users who are
+// related to an object. It is the same object as a Contact.
+type Profile = Contact
+
+// GetPersonalConnections returns the profiles of the users the current user has worked with.
+func (client *Client) GetPersonalConnections(ctx context.Context) (profiles []*Profile, err error) {
+	err = client.requestContext(ctx, "GET", "/profile/connections", nil, nil, &profiles)
+	return
+}
+
+// ContactListOptions limits the contacts returned by GetOrgContacts and GetSpaceContacts.
+// Zero values are left out and the Podio defaults apply.
+type ContactListOptions struct {
+	Type    string // contact type: user or space, both if empty
+	OrderBy string // name, last_seen_on, assigned, rating, ...
+	Limit   int
+	Offset  int
+}
+
+func (opts *ContactListOptions) query() string {
+	values := url.Values{}
+	if opts != nil {
+		if opts.Type != "" {
+			values.Set("contact_type", opts.Type)
+		}
+		if opts.OrderBy != "" {
+			values.Set("order", opts.OrderBy)
+		}
+		if opts.Limit > 0 {
+			values.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			values.Set("offset", strconv.Itoa(opts.Offset))
+		}
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// GetOrgContacts returns the contacts in an organization. opts may be nil.
+//
+// https://developers.podio.com/doc/contacts/get-organization-contacts-22401
+func (client *Client) GetOrgContacts(ctx context.Context, orgId int64, opts *ContactListOptions) (contacts []*Contact, err error) {
+	path := fmt.Sprintf("/contact/org/%d", orgId)
+	err = client.requestContext(ctx, "GET", path+opts.query(), nil, nil, &contacts)
+	return
+}
+
+// GetSpaceContacts returns the contacts in a space. opts may be nil.
+//
+// https://developers.podio.com/doc/contacts/get-space-contacts-22414
+func (client *Client) GetSpaceContacts(ctx context.Context, spaceId int64, opts *ContactListOptions) (contacts []*Contact, err error) {
+	path := fmt.Sprintf("/contact/space/%d/", spaceId)
+	err = client.requestContext(ctx, "GET", path+opts.query(), nil, nil, &contacts)
+	return
+}
@@ -0,0 +1,57 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetScopedContacts(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"user_id": 2468975, "profile_id": 140798621, "type": "user", "name": "Brian Stengaard"}]`))
+	})
+
+	ctx := context.Background()
+	contacts, err := client.GetOrgContacts(ctx, 736, nil)
+	r.NoError(err)
+	r.Len(contacts, 1)
+	r.Equal(140798621, contacts[0].ProfileId)
+
+	_, err = client.GetOrgContacts(ctx, 736, &ContactListOptions{Limit: 50, Offset: 100})
+	r.NoError(err)
+
+	_, err = client.GetSpaceContacts(ctx, 2720177, &ContactListOptions{Type: "user", OrderBy: "name", Limit: 50})
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/contact/org/736",
+		"/contact/org/736?limit=50&offset=100",
+		"/contact/space/2720177/?contact_type=user&limit=50&order=name",
+	}, urls)
+}
+
+func TestGetPersonalConnections(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/profile/connections", req.URL.Path)
+		w.Write([]byte(`[
+			{"user_id": 2468975, "profile_id": 140798621, "type": "user", "name": "Brian Stengaard"},
+			{"user_id": 4587, "profile_id": 9205, "type": "user", "name": "Andreas Garnaes"}
+		]`))
+	})
+
+	profiles, err := client.GetPersonalConnections(context.Background())
+	r.NoError(err)
+	r.Len(profiles, 2)
+	r.Equal(140798621, profiles[0].ProfileId)
+	r.Equal("Andreas Garnaes", profiles[1].Name)
+}
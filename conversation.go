@@ -0,0 +1,148 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Conversation is a private conversation between users. Excerpt is the start of the
+// latest message.
+type Conversation struct {
+	Id           int64      `json:"conversation_id"`
+	Type         string     `json:"type"` // direct or group
+	Subject      string     `json:"subject"`
+	Excerpt      string     `json:"excerpt"`
+	Unread       bool       `json:"unread"`
+	UnreadCount  int        `json:"unread_count"`
+	Starred      bool       `json:"starred"`
+	Participants []*Profile `json:"participants"`
+	CreatedBy    ByLine     `json:"created_by"`
+	CreatedOn    Time       `json:"created_on"`
+	Link         string     `json:"link"`
+}
+
+// ConversationListOptions limits the conversations returned by GetConversations.
+// Zero values are left out and the Podio defaults apply.
+type ConversationListOptions struct {
+	Limit  int
+	Offset int
+}
+
+func (opts *ConversationListOptions) query() string {
+	values := url.Values{}
+	if opts != nil {
+		if opts.Limit > 0 {
+			values.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			values.Set("offset", strconv.Itoa(opts.Offset))
+		}
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// GetConversations returns the conversations of the authenticated user, most recently
+// active first. opts may be nil.
+//
+// https://developers.podio.com/doc/conversations/get-conversations-34822
+func (client *Client) GetConversations(ctx context.Context, opts *ConversationListOptions) (conversations []*Conversation, err error) {
+	err = client.requestContext(ctx, "GET", "/conversation/"+opts.query(), nil, nil, &conversations)
+	return
+}
+
+// https://developers.podio.com/doc/conversations/get-conversation-22369
+func (client *Client) GetConversation(ctx context.Context, conversationId int64) (conversation *Conversation, err error) {
+	path := fmt.Sprintf("/conversation/%d", conversationId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &conversation)
+	return
+}
+
+// CreateConversationRequest describes a new conversation and its first message.
+// Participants are the profile ids of the users to include besides the sender.
+type CreateConversationRequest struct {
+	Subject      string
+	Text         string
+	Participants []int64
+	FileIds      []int64
+}
+
+// CreateConversation starts a conversation and returns it. Only the id and subject of
+// the returned conversation are set; use GetConversation for the rest.
+//
+// https://developers.podio.com/doc/conversations/create-conversation-v2-37301474
+func (client *Client) CreateConversation(ctx context.Context, req *CreateConversationRequest) (*Conversation, error) {
+	params := map[string]interface{}{
+		"subject":      req.Subject,
+		"text":         req.Text,
+		"participants": req.Participants,
+	}
+	if len(req.FileIds) > 0 {
+		params["file_ids"] = req.FileIds
+	}
+
+	rsp := &struct {
+		ConversationId int64 `json:"conversation_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", "/conversation/v2/", nil, params, rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Conversation{Id: rsp.ConversationId, Subject: req.Subject}, nil
+}
+
+// ReplyToConversation adds a message to a conversation. fileIds may be nil.
+//
+// https://developers.podio.com/doc/conversations/reply-to-conversation-v2-37260916
+func (client *Client) ReplyToConversation(ctx context.Context, conversationId int64, text string, fileIds []int64) error {
+	path := fmt.Sprintf("/conversation/%d/reply/v2", conversationId)
+	params := map[string]interface{}{
+		"text": text,
+	}
+	if len(fileIds) > 0 {
+		params["file_ids"] = fileIds
+	}
+
+	return client.requestWithParamsContext(ctx, "POST", path, nil, params, nil)
+}
+
+// ConversationMessage is a message in a conversation
+type ConversationMessage struct {
+	Id        int64   `json:"message_id"`
+	Text      string  `json:"text"`
+	Files     []*File `json:"files"`
+	CreatedBy ByLine  `json:"created_by"`
+	CreatedOn Time    `json:"created_on"`
+}
+
+// MessageListOptions limits the messages returned by GetConversationMessages.
+// Zero values are left out and the Podio defaults apply.
+type MessageListOptions ConversationListOptions
+
+// GetConversationMessages returns the messages of a conversation, newest first.
+// opts may be nil.
+//
+// https://developers.podio.com/doc/conversations/get-messages-in-conversation-35524302
+func (client *Client) GetConversationMessages(ctx context.Context, conversationId int64, opts *MessageListOptions) (messages []*ConversationMessage, err error) {
+	path := fmt.Sprintf("/conversation/%d/message/", conversationId)
+	err = client.requestContext(ctx, "GET", path+(*ConversationListOptions)(opts).query(), nil, nil, &messages)
+	return
+}
+
+// AddConversationParticipant adds the user with the given profile id to a group conversation
+//
+// https://developers.podio.com/doc/conversations/add-participants-v2-37282400
+func (client *Client) AddConversationParticipant(ctx context.Context, conversationId, profileId int64) error {
+	path := fmt.Sprintf("/conversation/%d/participant/v2/", conversationId)
+	params := map[string]interface{}{
+		"participants": []int64{profileId},
+	}
+
+	return client.requestWithParamsContext(ctx, "POST", path, nil, params, nil)
+}
@@ -0,0 +1,162 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConversations(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{
+			"conversation_id": 443317462,
+			"type": "group",
+			"subject": "Offer",
+			"excerpt": "Looks good to me",
+			"unread": true,
+			"unread_count": 2,
+			"participants": [{"user_id": 2468975, "profile_id": 140798621, "name": "Brian Stengaard"}],
+			"created_on": "2017-03-21 23:29:07"
+		}]`))
+	})
+
+	conversations, err := client.GetConversations(context.Background(), &ConversationListOptions{Limit: 20, Offset: 40})
+	r.NoError(err)
+	r.Len(conversations, 1)
+
+	c := conversations[0]
+	r.Equal(int64(443317462), c.Id)
+	r.Equal("Offer", c.Subject)
+	r.Equal("Looks good to me", c.Excerpt)
+	r.True(c.Unread)
+	r.Len(c.Participants, 1)
+	r.Equal(140798621, c.Participants[0].ProfileId)
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), c.CreatedOn)
+
+	_, err = client.GetConversations(context.Background(), nil)
+	r.NoError(err)
+
+	r.Equal([]string{"/conversation/?limit=20&offset=40", "/conversation/"}, urls)
+}
+
+func TestGetConversation(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/conversation/443317462", req.URL.Path)
+		w.Write([]byte(`{"conversation_id": 443317462, "subject": "Offer", "unread": false}`))
+	})
+
+	c, err := client.GetConversation(context.Background(), 443317462)
+	r.NoError(err)
+	r.Equal("Offer", c.Subject)
+	r.False(c.Unread)
+}
+
+func TestCreateConversation(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/conversation/v2/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"subject": "Offer", "text": "Have a look", "participants": [140798621], "file_ids": [195174666]}`, string(body))
+
+		w.Write([]byte(`{"conversation_id": 443317463, "message_id": 1}`))
+	})
+
+	c, err := client.CreateConversation(context.Background(), &CreateConversationRequest{
+		Subject:      "Offer",
+		Text:         "Have a look",
+		Participants: []int64{140798621},
+		FileIds:      []int64{195174666},
+	})
+	r.NoError(err)
+	r.Equal(&Conversation{Id: 443317463, Subject: "Offer"}, c)
+}
+
+func TestReplyToConversation(t *testing.T) {
+	r := require.New(t)
+
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/conversation/443317462/reply/v2", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		bodies = append(bodies, string(body))
+
+		w.Write([]byte(`{"message_id": 2}`))
+	})
+
+	r.NoError(client.ReplyToConversation(context.Background(), 443317462, "Agreed", nil))
+	r.NoError(client.ReplyToConversation(context.Background(), 443317462, "See attached", []int64{195174666}))
+
+	r.JSONEq(`{"text": "Agreed"}`, bodies[0])
+	r.JSONEq(`{"text": "See attached", "file_ids": [195174666]}`, bodies[1])
+}
+
+func TestGetConversationMessages(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{
+			"message_id": 1162150438,
+			"text": "Looks good to me",
+			"files": [{"file_id": 195174666, "name": "offer.pdf"}],
+			"created_by": {"id": 2468975, "type": "user", "name": "Brian Stengaard"},
+			"created_on": "2017-03-21 23:29:07"
+		}]`))
+	})
+
+	messages, err := client.GetConversationMessages(context.Background(), 443317462, &MessageListOptions{Limit: 50, Offset: 100})
+	r.NoError(err)
+	r.Len(messages, 1)
+
+	m := messages[0]
+	r.Equal(int64(1162150438), m.Id)
+	r.Equal("Looks good to me", m.Text)
+	r.Equal("offer.pdf", m.Files[0].Name)
+	r.Equal("Brian Stengaard", m.CreatedBy.Name)
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), m.CreatedOn)
+
+	_, err = client.GetConversationMessages(context.Background(), 443317462, nil)
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/conversation/443317462/message/?limit=50&offset=100",
+		"/conversation/443317462/message/",
+	}, urls)
+}
+
+func TestAddConversationParticipant(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/conversation/443317462/participant/v2/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"participants": [140798621]}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.AddConversationParticipant(context.Background(), 443317462, 140798621))
+}
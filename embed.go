@@ -1,16 +1,42 @@
 package podio
 
+import (
+	"context"
+	"fmt"
+)
+
 // Embed describes a Podio embed object
 type Embed struct {
-	Id          int    `json:"embed_id"`
-	Type        string `json:"type"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	EmbedHTML   string `json:"embed_html"`
-	URL         string `json:"url"`
-	OriginalURL string `json:"original_url"`
-	ResolvedURL string `json:"resolved_url"`
-	Hostname    string `json:"hostname"`
-	EmbedHeight int    `json:"embed_height"`
-	EmbedWidth  int    `json:"embed_width"`
+	Id          int64   `json:"embed_id"`
+	Type        string  `json:"type"` // link, video, rich or image
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	EmbedHTML   string  `json:"embed_html"`
+	URL         string  `json:"url"`
+	OriginalURL string  `json:"original_url"`
+	ResolvedURL string  `json:"resolved_url"`
+	Hostname    string  `json:"hostname"`
+	EmbedHeight int     `json:"embed_height"`
+	EmbedWidth  int     `json:"embed_width"`
+	Files       []*File `json:"files"` // thumbnails of the embedded page
+}
+
+// CreateEmbed makes Podio fetch the page at url and returns it as an embed, which can
+// then be attached to comments, statuses and embed fields.
+//
+// https://developers.podio.com/doc/embeds/add-an-embed-726483
+func (client *Client) CreateEmbed(ctx context.Context, url string) (embed *Embed, err error) {
+	params := map[string]interface{}{
+		"url": url,
+	}
+
+	err = client.requestWithParamsContext(ctx, "POST", "/embed/", nil, params, &embed)
+	return
+}
+
+// https://developers.podio.com/doc/embeds/get-embed-37559
+func (client *Client) GetEmbed(ctx context.Context, embedId int64) (embed *Embed, err error) {
+	path := fmt.Sprintf("/embed/%d", embedId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &embed)
+	return
 }
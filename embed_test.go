@@ -0,0 +1,68 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const embedJSON = `{
+	"embed_id": 124829363,
+	"type": "video",
+	"title": "Podio introduction",
+	"description": "A short tour of Podio",
+	"original_url": "https://www.youtube.com/watch?v=abc123",
+	"resolved_url": "https://www.youtube.com/watch?v=abc123",
+	"hostname": "www.youtube.com",
+	"embed_width": 480,
+	"embed_height": 270,
+	"files": [{"file_id": 195174667, "link": "https://files.podio.com/195174667"}]
+}`
+
+func TestCreateEmbed(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/embed/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"url": "https://www.youtube.com/watch?v=abc123"}`, string(body))
+
+		w.Write([]byte(embedJSON))
+	})
+
+	embed, err := client.CreateEmbed(context.Background(), "https://www.youtube.com/watch?v=abc123")
+	r.NoError(err)
+	r.Equal(&Embed{
+		Id:          124829363,
+		Type:        "video",
+		Title:       "Podio introduction",
+		Description: "A short tour of Podio",
+		OriginalURL: "https://www.youtube.com/watch?v=abc123",
+		ResolvedURL: "https://www.youtube.com/watch?v=abc123",
+		Hostname:    "www.youtube.com",
+		EmbedWidth:  480,
+		EmbedHeight: 270,
+		Files:       []*File{{Id: 195174667, Link: "https://files.podio.com/195174667"}},
+	}, embed)
+}
+
+func TestGetEmbed(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/embed/124829363", req.URL.Path)
+		w.Write([]byte(embedJSON))
+	})
+
+	embed, err := client.GetEmbed(context.Background(), 124829363)
+	r.NoError(err)
+	r.Equal(int64(124829363), embed.Id)
+	r.Equal("Podio introduction", embed.Title)
+}
@@ -0,0 +1,160 @@
+package podio
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Sentinel errors for the most common kinds of Podio errors, for use with errors.Is:
+//
+//	if errors.Is(err, podio.ErrNotFound) { ... }
+//
+// An *Error matches a sentinel if it has the same Type.
+var (
+	ErrNotFound     = &Error{Type: "not_found", Description: "object not found"}
+	ErrUnauthorized = &Error{Type: "unauthorized", Description: "not authenticated"}
+	ErrForbidden    = &Error{Type: "forbidden", Description: "no access"}
+	ErrRateLimited  = &Error{Type: "rate_limit", Description: "rate limit exceeded"}
+	ErrInvalidValue = &Error{Type: "invalid_value", Description: "invalid request"}
+	ErrConflict     = &Error{Type: "conflict", Description: "conflicting change"}
+	ErrGone         = &Error{Type: "gone", Description: "object deleted"}
+	ErrUnavailable  = &Error{Type: "unavailable", Description: "service unavailable"}
+)
+
+// Is reports whether target is an *Error of the same kind, e.g. one of the sentinel
+// errors. The kind of an error is its Type, or for the statuses of the typed errors
+// the type of their sentinel, so a 404 matches ErrNotFound whatever its Type.
+func (p *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Type != "" && (t.Type == p.Type || t.Type == p.kind())
+}
+
+// As sets target to the error if target is a **Error, or to the typed error for the
+// kind of the error if target is e.g. a **NotFoundError
+func (p *Error) As(target interface{}) bool {
+	switch t := target.(type) {
+	case **Error:
+		*t = p
+	case **NotFoundError:
+		if p.kind() != ErrNotFound.Type {
+			return false
+		}
+		*t = &NotFoundError{Err: p}
+	case **AuthError:
+		if p.kind() != ErrUnauthorized.Type {
+			return false
+		}
+		*t = &AuthError{Err: p}
+	case **RateLimitError:
+		if p.kind() != ErrRateLimited.Type {
+			return false
+		}
+		*t = &RateLimitError{Err: p, RetryAfter: p.retryAfter}
+	case **ValidationError:
+		if p.kind() != ErrInvalidValue.Type {
+			return false
+		}
+		params, _ := p.Parameters.(map[string]interface{})
+		*t = &ValidationError{Err: p, Parameters: params}
+	default:
+		return false
+	}
+	return true
+}
+
+// kind classifies the error by the HTTP status code of the response, falling back to
+// the Type of the error
+func (p *Error) kind() string {
+	switch p.HTTPStatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound.Type
+	case http.StatusUnauthorized:
+		return ErrUnauthorized.Type
+	case http.StatusTooManyRequests, statusTooManyRequestsPodio:
+		return ErrRateLimited.Type
+	case http.StatusUnprocessableEntity:
+		return ErrInvalidValue.Type
+	}
+	return p.Type
+}
+
+// NotFoundError is the view of an *Error for objects that do not exist or are not
+// visible to the authenticated user. Podio errors are always returned as *Error; use
+// errors.As to get the typed error:
+//
+//	nf := &podio.NotFoundError{}
+//	if errors.As(err, &nf) { ... }
+type NotFoundError struct {
+	Err *Error
+}
+
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+func (e *NotFoundError) Error() string        { return e.Err.Error() }
+func (e *NotFoundError) Unwrap() error        { return e.Err }
+
+// AuthError is the view of an *Error for a missing, invalid or expired access token.
+type AuthError struct {
+	Err *Error
+}
+
+func (e *AuthError) Is(target error) bool { return target == ErrUnauthorized }
+func (e *AuthError) Error() string        { return e.Err.Error() }
+func (e *AuthError) Unwrap() error        { return e.Err }
+
+// RateLimitError is the view of an *Error for clients that have made too many requests.
+// RetryAfter is the time to wait before trying again, or 0 if Podio did not say.
+type RateLimitError struct {
+	Err        *Error
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Is(target error) bool { return target == ErrRateLimited }
+func (e *RateLimitError) Error() string        { return e.Err.Error() }
+func (e *RateLimitError) Unwrap() error        { return e.Err }
+
+// ValidationError is the view of an *Error for requests whose values Podio rejects.
+// Parameters holds the error parameters of the error, e.g. the fields at fault, if they
+// are an object.
+type ValidationError struct {
+	Err        *Error
+	Parameters map[string]interface{}
+}
+
+func (e *ValidationError) Is(target error) bool { return target == ErrInvalidValue }
+func (e *ValidationError) Error() string        { return e.Err.Error() }
+func (e *ValidationError) Unwrap() error        { return e.Err }
+
+// statusTooManyRequestsPodio is the status Podio has traditionally used for rate limiting
+const statusTooManyRequestsPodio = 420
+
+// newResponseError builds the *Error for a non-2xx response. Responses that are not
+// Podio errors are returned as plain errors with the body as the message.
+func newResponseError(statusCode int, header http.Header, body []byte) error {
+	podioErr := &Error{}
+	if err := json.Unmarshal(body, podioErr); err != nil {
+		return errors.New(string(body))
+	}
+	podioErr.HTTPStatusCode = statusCode
+	podioErr.retryAfter = retryAfter(header)
+	return podioErr
+}
+
+// retryAfter reads the Retry-After header, which is either a number of seconds or a date
+func retryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
@@ -0,0 +1,164 @@
+package podio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newErrorClient(t *testing.T, status int, header map[string]string, body string) *Client {
+	return newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		for k, v := range header {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	})
+}
+
+func TestNotFoundError(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusNotFound, nil, `{"error": "not_found", "error_description": "Object not found"}`)
+	_, err := client.GetItem(1)
+
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected error to be *podio.Error, is %#v", err)
+	r.Equal("not_found", podioErr.Type)
+	r.True(errors.Is(err, ErrNotFound))
+	r.False(errors.Is(err, ErrUnauthorized))
+	r.EqualError(err, "not_found: Object not found")
+
+	notFoundErr := &NotFoundError{}
+	r.True(errors.As(err, &notFoundErr))
+	r.Equal(podioErr, notFoundErr.Err)
+	r.True(errors.Is(notFoundErr, ErrNotFound))
+	authErr := &AuthError{}
+	r.False(errors.As(err, &authErr))
+}
+
+func TestAuthError(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusUnauthorized, nil, `{"error": "unauthorized", "error_description": "expired_token"}`)
+	_, err := client.GetItem(1)
+
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected error to be *podio.Error, is %#v", err)
+	r.Equal("unauthorized", podioErr.Type)
+	r.True(errors.Is(err, ErrUnauthorized))
+
+	authErr := &AuthError{}
+	r.True(errors.As(err, &authErr))
+	r.Equal(podioErr, authErr.Err)
+	notFoundErr := &NotFoundError{}
+	r.False(errors.As(err, &notFoundErr))
+}
+
+func TestRateLimitError(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusTooManyRequests, map[string]string{"Retry-After": "120"},
+		`{"error": "rate_limit", "error_description": "You have hit the rate limit"}`)
+	_, err := client.GetItem(1)
+
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected error to be *podio.Error, is %#v", err)
+	r.Equal("rate_limit", podioErr.Type)
+
+	rateErr := &RateLimitError{}
+	r.True(errors.As(err, &rateErr))
+	r.Equal(2*time.Minute, rateErr.RetryAfter)
+	r.True(errors.Is(err, ErrRateLimited))
+
+	// Podio's own rate limit status, without Retry-After
+	client = newErrorClient(t, 420, nil, `{"error": "rate_limit", "error_description": "You have hit the rate limit"}`)
+	_, err = client.GetItem(1)
+	r.True(errors.As(err, &rateErr))
+	r.Equal(time.Duration(0), rateErr.RetryAfter)
+}
+
+func TestValidationError(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusUnprocessableEntity, nil,
+		`{"error": "invalid_value", "error_description": "Invalid value for title", "error_parameters": {"field": "title"}}`)
+	_, err := client.GetItem(1)
+
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected error to be *podio.Error, is %#v", err)
+	r.Equal("invalid_value", podioErr.Type)
+
+	validationErr := &ValidationError{}
+	r.True(errors.As(err, &validationErr))
+	r.Equal(map[string]interface{}{"field": "title"}, validationErr.Parameters)
+	r.True(errors.Is(err, ErrInvalidValue))
+}
+
+func TestOtherErrors(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusForbidden, nil, `{"error": "forbidden", "error_description": "No access"}`)
+	_, err := client.GetItem(1)
+	r.IsType(&Error{}, err)
+	r.False(errors.Is(err, ErrNotFound))
+
+	client = newErrorClient(t, http.StatusBadGateway, nil, `Bad gateway`)
+	_, err = client.GetItem(1)
+	r.EqualError(err, "Bad gateway")
+}
+
+func TestErrorHTTPStatusCode(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusNotFound, nil, `{"error": "not_found", "error_description": "Object not found"}`)
+	_, err := client.GetItem(1)
+	podioErr := &Error{}
+	r.True(errors.As(err, &podioErr))
+	r.Equal(http.StatusNotFound, podioErr.HTTPStatusCode)
+
+	client = newErrorClient(t, http.StatusInternalServerError, nil, `{"error": "unavailable", "error_description": "Service unavailable"}`)
+	_, err = client.GetItem(1)
+	r.True(errors.As(err, &podioErr))
+	r.Equal(http.StatusInternalServerError, podioErr.HTTPStatusCode)
+
+	r.Equal(0, ErrNotFound.HTTPStatusCode)
+}
+
+func TestErrorIsAndAs(t *testing.T) {
+	r := require.New(t)
+
+	client := newErrorClient(t, http.StatusForbidden, nil, `{"error": "forbidden", "error_description": "No access"}`)
+	_, err := client.GetItem(1)
+
+	// callers checking the type directly keep working, see the tests above for the
+	// statuses of the typed errors
+	_, ok := err.(*Error)
+	r.True(ok)
+
+	wrapped := fmt.Errorf("wrapping: %w", err)
+	r.True(errors.Is(wrapped, ErrForbidden))
+	r.False(errors.Is(wrapped, ErrNotFound))
+	r.False(errors.Is(wrapped, &Error{}))
+
+	podioErr := &Error{}
+	r.True(errors.As(wrapped, &podioErr))
+	r.Equal("No access", podioErr.Description)
+
+	// errors of the statuses of the typed errors match both the sentinel of their status
+	// and that of their type
+	client = newErrorClient(t, http.StatusNotFound, nil, `{"error": "gone", "error_description": "Item deleted"}`)
+	_, err = client.GetItem(1)
+	wrapped = fmt.Errorf("wrapping: %w", err)
+	r.True(errors.Is(wrapped, ErrNotFound))
+	r.True(errors.Is(wrapped, ErrGone))
+	r.True(errors.As(wrapped, &podioErr))
+	r.Equal("Item deleted", podioErr.Description)
+	notFoundErr := &NotFoundError{}
+	r.True(errors.As(wrapped, &notFoundErr))
+	r.Equal("Item deleted", notFoundErr.Err.Description)
+}
@@ -0,0 +1,239 @@
+package podio
+
+import (
+	"fmt"
+	"time"
+)
+
+// FieldTypeError is returned by the typed field accessors when a field is not of
+// the type the accessor reads.
+type FieldTypeError struct {
+	FieldId  int64
+	Type     string // the actual type of the field
+	Expected string // the type expected by the accessor
+}
+
+func (e *FieldTypeError) Error() string {
+	return fmt.Sprintf("field %d is of type %q, not %q", e.FieldId, e.Type, e.Expected)
+}
+
+func (f *Field) checkType(expected string) error {
+	if f.Type != expected {
+		return &FieldTypeError{FieldId: f.Id, Type: f.Type, Expected: expected}
+	}
+	return nil
+}
+
+// GetTextValue returns the value of a text field, or "" if the field is empty.
+func (f *Field) GetTextValue() (string, error) {
+	if err := f.checkType("text"); err != nil {
+		return "", err
+	}
+
+	values, _ := f.Values.([]TextValue)
+	if len(values) == 0 {
+		return "", nil
+	}
+	return values[0].Value, nil
+}
+
+// GetNumberValue returns the value of a number field, or 0 if the field is empty.
+func (f *Field) GetNumberValue() (float64, error) {
+	if err := f.checkType("number"); err != nil {
+		return 0, err
+	}
+
+	values, _ := f.Values.([]NumberValue)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	return values[0].Value, nil
+}
+
+// GetMoneyValue returns the value of a money field, or nil if the field is empty.
+func (f *Field) GetMoneyValue() (*MoneyValue, error) {
+	if err := f.checkType("money"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]MoneyValue)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return &values[0], nil
+}
+
+// GetProgressValue returns the value of a progress field (0 - 100), or 0 if the field is empty.
+func (f *Field) GetProgressValue() (int, error) {
+	if err := f.checkType("progress"); err != nil {
+		return 0, err
+	}
+
+	values, _ := f.Values.([]ProgressValue)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	return values[0].Value, nil
+}
+
+// GetDurationValue returns the value of a duration field, or 0 if the field is empty.
+func (f *Field) GetDurationValue() (time.Duration, error) {
+	if err := f.checkType("duration"); err != nil {
+		return 0, err
+	}
+
+	values, _ := f.Values.([]DurationValue)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	return time.Duration(values[0].Value) * time.Second, nil
+}
+
+// GetDateValue returns the value of a date field, or nil if the field is empty.
+func (f *Field) GetDateValue() (*DateValue, error) {
+	if err := f.checkType("date"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]DateValue)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return &values[0], nil
+}
+
+// GetLocationValue returns the value of a location field, or nil if the field is empty.
+func (f *Field) GetLocationValue() (*LocationValue, error) {
+	if err := f.checkType("location"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]LocationValue)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return &values[0], nil
+}
+
+// GetCalculationValue returns the value of a calculation field, or "" if the field is empty.
+func (f *Field) GetCalculationValue() (string, error) {
+	if err := f.checkType("calculation"); err != nil {
+		return "", err
+	}
+
+	values, _ := f.Values.([]CalculationValue)
+	if len(values) == 0 {
+		return "", nil
+	}
+	return values[0].Value, nil
+}
+
+// GetCategoryValues returns the selected options of a category field.
+func (f *Field) GetCategoryValues() ([]CategoryValue, error) {
+	if err := f.checkType("category"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]CategoryValue)
+	return values, nil
+}
+
+// GetAppValues returns the items referenced by an app field.
+func (f *Field) GetAppValues() ([]AppValue, error) {
+	if err := f.checkType("app"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]AppValue)
+	return values, nil
+}
+
+// GetContactValues returns the contacts of a contact field.
+func (f *Field) GetContactValues() ([]ContactValue, error) {
+	if err := f.checkType("contact"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]ContactValue)
+	return values, nil
+}
+
+// GetMemberValues returns the values of a member field.
+func (f *Field) GetMemberValues() ([]MemberValue, error) {
+	if err := f.checkType("member"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]MemberValue)
+	return values, nil
+}
+
+// GetImageValues returns the images of an image field.
+func (f *Field) GetImageValues() ([]ImageValue, error) {
+	if err := f.checkType("image"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]ImageValue)
+	return values, nil
+}
+
+// GetEmbedValues returns the links of an embed field.
+func (f *Field) GetEmbedValues() ([]EmbedValue, error) {
+	if err := f.checkType("embed"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]EmbedValue)
+	return values, nil
+}
+
+// GetVideoValues returns the values of a video field.
+func (f *Field) GetVideoValues() ([]VideoValue, error) {
+	if err := f.checkType("video"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]VideoValue)
+	return values, nil
+}
+
+// GetQuestionValues returns the answers of a question field.
+func (f *Field) GetQuestionValues() ([]QuestionValue, error) {
+	if err := f.checkType("question"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]QuestionValue)
+	return values, nil
+}
+
+// GetTelValues returns the values of the deprecated tel field.
+func (f *Field) GetTelValues() ([]TelValue, error) {
+	if err := f.checkType("tel"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]TelValue)
+	return values, nil
+}
+
+// GetPhoneValues returns the phone numbers of a phone field.
+func (f *Field) GetPhoneValues() ([]PhoneValue, error) {
+	if err := f.checkType("phone"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]PhoneValue)
+	return values, nil
+}
+
+// GetEmailValues returns the email addresses of an email field.
+func (f *Field) GetEmailValues() ([]EmailValue, error) {
+	if err := f.checkType("email"); err != nil {
+		return nil, err
+	}
+
+	values, _ := f.Values.([]EmailValue)
+	return values, nil
+}
@@ -0,0 +1,86 @@
+package podio
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldAccessors(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{}
+	err := json.Unmarshal(getFixtureJSON(t, "fixtures/item_225607452.json"), item)
+	r.NoError(err)
+
+	text, err := item.Fields[0].GetTextValue()
+	r.NoError(err)
+	r.Equal("Title", text)
+
+	categories, err := item.Fields[1].GetCategoryValues()
+	r.NoError(err)
+	r.Len(categories, 1)
+	r.Equal("B", categories[0].Value.Text)
+
+	date, err := item.Fields[2].GetDateValue()
+	r.NoError(err)
+	r.Equal(parseTime(t, "2014-12-11 22:00:00"), date.Start)
+
+	contacts, err := item.Fields[3].GetContactValues()
+	r.NoError(err)
+	r.Len(contacts, 1)
+	r.Equal("Brian Stengaard", contacts[0].Value.Name)
+
+	number, err := item.Fields[4].GetNumberValue()
+	r.NoError(err)
+	r.Equal(6513.51, number)
+
+	money, err := item.Fields[5].GetMoneyValue()
+	r.NoError(err)
+	r.Equal("EUR", money.Currency)
+
+	progressItem := &Item{}
+	err = json.Unmarshal(getFixtureJSON(t, "fixtures/item_582709679.json"), progressItem)
+	r.NoError(err)
+
+	progress, err := progressItem.Fields[0].GetProgressValue()
+	r.NoError(err)
+	r.Equal(42, progress)
+
+	duration, err := progressItem.Fields[2].GetDurationValue()
+	r.NoError(err)
+	r.Equal(26*time.Hour+3*time.Minute+4*time.Second, duration)
+}
+
+func TestFieldAccessorTypeMismatch(t *testing.T) {
+	r := require.New(t)
+
+	field := &Field{}
+	err := json.Unmarshal([]byte(`{"field_id": 7, "type": "number", "values": [{"value": "1.5"}]}`), field)
+	r.NoError(err)
+
+	_, err = field.GetTextValue()
+	r.Error(err)
+
+	typeErr, ok := err.(*FieldTypeError)
+	r.True(ok, "Expected error to be *podio.FieldTypeError, is %#v", err)
+	r.Equal(&FieldTypeError{FieldId: 7, Type: "number", Expected: "text"}, typeErr)
+	r.Equal(`field 7 is of type "number", not "text"`, err.Error())
+
+	_, err = field.GetCategoryValues()
+	r.Error(err)
+}
+
+func TestFieldAccessorEmptyField(t *testing.T) {
+	r := require.New(t)
+
+	field := &Field{}
+	err := json.Unmarshal([]byte(`{"type": "text", "values": []}`), field)
+	r.NoError(err)
+
+	text, err := field.GetTextValue()
+	r.NoError(err)
+	r.Equal("", text)
+}
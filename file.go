@@ -2,18 +2,72 @@ package podio
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type File struct {
-	Id   int64  `json:"file_id"`
-	Name string `json:"name"`
-	Link string `json:"link"`
-	Size int    `json:"size"`
-	Push Push   `json:"push"`
+	Id        int64  `json:"file_id"`
+	Name      string `json:"name"`
+	Link      string `json:"link"`
+	Size      int    `json:"size"`
+	MimeType  string `json:"mimetype"`
+	CreatedOn Time   `json:"created_on"`
+	Push      Push   `json:"push"`
+
+	Attached   bool     `json:"attached"`
+	AttachedTo *FileRef `json:"attached_to"`
+}
+
+// FileRef is the object a file is attached to
+type FileRef struct {
+	RefType string `json:"ref_type"`
+	RefId   int64  `json:"ref_id"`
+}
+
+// FileListOptions limits the files returned when listing files.
+// Zero values are left out and the Podio defaults apply.
+//
+// MimeType, Attached and SpaceId are only used by GetFilesWithOptions.
+type FileListOptions struct {
+	Limit  int
+	Offset int
+
+	MimeType string
+	Attached bool   // only return files that are attached to an object
+	SpaceId  *int64 // only return files in this space
+}
+
+func (opts *FileListOptions) query() string {
+	values := url.Values{}
+	if opts != nil {
+		if opts.Limit > 0 {
+			values.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			values.Set("offset", strconv.Itoa(opts.Offset))
+		}
+		if opts.MimeType != "" {
+			values.Set("mimetype", opts.MimeType)
+		}
+		if opts.Attached {
+			values.Set("attached", "true")
+		}
+		if opts.SpaceId != nil {
+			values.Set("space_id", strconv.FormatInt(*opts.SpaceId, 10))
+		}
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
 }
 
 // https://developers.podio.com/doc/files/get-files-4497983
@@ -22,6 +76,38 @@ func (client *Client) GetFiles() (files []File, err error) {
 	return
 }
 
+// GetFilesWithOptions is GetFiles with paging and filtering. opts may be nil.
+//
+// https://developers.podio.com/doc/files/get-files-4497983
+func (client *Client) GetFilesWithOptions(ctx context.Context, opts *FileListOptions) ([]*File, error) {
+	return client.getFiles(ctx, "/file/", opts)
+}
+
+// GetFilesByItem returns the files attached to an item. opts may be nil.
+func (client *Client) GetFilesByItem(ctx context.Context, itemId int64, opts *FileListOptions) ([]*File, error) {
+	return client.getFiles(ctx, fmt.Sprintf("/file/item/%d/", itemId), opts)
+}
+
+// GetFilesByApp returns the files attached to items in an app. opts may be nil.
+//
+// https://developers.podio.com/doc/files/get-files-on-app-22472
+func (client *Client) GetFilesByApp(ctx context.Context, appId int64, opts *FileListOptions) ([]*File, error) {
+	return client.getFiles(ctx, fmt.Sprintf("/file/app/%d/", appId), opts)
+}
+
+// GetFilesBySpace returns the files in a space. opts may be nil.
+//
+// https://developers.podio.com/doc/files/get-files-on-space-22471
+func (client *Client) GetFilesBySpace(ctx context.Context, spaceId int64, opts *FileListOptions) ([]*File, error) {
+	return client.getFiles(ctx, fmt.Sprintf("/file/space/%d/", spaceId), opts)
+}
+
+// getFiles lists the files at path, filtered by opts
+func (client *Client) getFiles(ctx context.Context, path string, opts *FileListOptions) (files []*File, err error) {
+	err = client.requestContext(ctx, "GET", path+opts.query(), nil, nil, &files)
+	return
+}
+
 // https://developers.podio.com/doc/files/get-file-22451
 func (client *Client) GetFile(fileId int) (file *File, err error) {
 	err = client.Request("GET", fmt.Sprintf("/file/%d", fileId), nil, nil, &file)
@@ -46,6 +132,30 @@ func (client *Client) GetFileContents(url string) ([]byte, error) {
 	return respBody, nil
 }
 
+// DownloadFileAsReader returns the contents of a file as a stream, without
+// reading the whole file into memory like GetFileContents does. The caller must
+// close the returned reader. Cancelling ctx aborts the download.
+func (client *Client) DownloadFileAsReader(ctx context.Context, url string) (io.ReadCloser, error) {
+	link := fmt.Sprintf("%s?oauth_token=%s", url, client.authToken.AccessToken)
+	req, err := http.NewRequest("GET", link, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if !(200 <= resp.StatusCode && resp.StatusCode < 300) {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, newResponseError(resp.StatusCode, resp.Header, respBody)
+	}
+
+	return resp.Body, nil
+}
+
 // https://developers.podio.com/doc/files/upload-file-1004361
 func (client *Client) CreateFile(name string, contents []byte) (file *File, err error) {
 	body := &bytes.Buffer{}
@@ -79,6 +189,49 @@ func (client *Client) CreateFile(name string, contents []byte) (file *File, err
 	return
 }
 
+// CreateFileFromReader uploads a file like CreateFile, but streams the contents from
+// r instead of holding them in memory. If size is greater than zero it must be the
+// exact number of bytes in r; it is used to set the Content-Length of the upload.
+//
+// https://developers.podio.com/doc/files/upload-file-1004361
+func (client *Client) CreateFileFromReader(ctx context.Context, name string, size int64, r io.Reader) (file *File, err error) {
+	// The multipart body is written around the contents of r: the form fields and
+	// the header of the file part go before it, the closing boundary after it.
+	head := &bytes.Buffer{}
+	writer := multipart.NewWriter(head)
+
+	err = writer.WriteField("filename", name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = writer.CreateFormFile("source", name)
+	if err != nil {
+		return nil, err
+	}
+	headLen := head.Len()
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	tail := append([]byte{}, head.Bytes()[headLen:]...)
+	head.Truncate(headLen)
+
+	body := io.MultiReader(head, r, bytes.NewReader(tail))
+	req, err := http.NewRequest("POST", client.baseURL+"/file", body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	if size > 0 {
+		req.ContentLength = int64(headLen) + size + int64(len(tail))
+	}
+
+	err = client.do(req.WithContext(ctx), &file)
+	return
+}
+
 // https://developers.podio.com/doc/files/replace-file-22450
 func (client *Client) ReplaceFile(oldFileId, newFileId int) error {
 	path := fmt.Sprintf("/file/%d/replace", newFileId)
@@ -97,6 +250,9 @@ func (client *Client) AttachFile(fileId int, refType string, refId int) error {
 		"ref_id":   refId,
 	}
 
+	if refType == "item" {
+		defer client.forgetItem(int64(refId))
+	}
 	return client.RequestWithParams("POST", path, nil, params, nil)
 }
 
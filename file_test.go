@@ -0,0 +1,243 @@
+package podio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadFileAsReader(t *testing.T) {
+	r := require.New(t)
+
+	chunk := strings.Repeat("a", 1<<20)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("token", req.URL.Query().Get("oauth_token"))
+
+		// send the first chunk and hold back the rest until the client has read it,
+		// which only works if the body is streamed rather than buffered.
+		w.Write([]byte(chunk))
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte(chunk))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthToken{AccessToken: "token"})
+	body, err := client.DownloadFileAsReader(context.Background(), server.URL+"/195174666")
+	r.NoError(err)
+	defer body.Close()
+
+	buf := make([]byte, len(chunk))
+	_, err = io.ReadFull(body, buf)
+	r.NoError(err)
+	r.Equal(chunk, string(buf))
+
+	close(release)
+	rest, err := ioutil.ReadAll(body)
+	r.NoError(err)
+	r.Len(rest, len(chunk))
+}
+
+func TestDownloadFileAsReaderError(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthToken{AccessToken: "token"})
+	body, err := client.DownloadFileAsReader(context.Background(), server.URL+"/195174666")
+	r.Nil(body)
+	r.EqualError(err, "not found")
+}
+
+func TestDownloadFileAsReaderPodioError(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "not_found", "error_description": "Object not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthToken{AccessToken: "token"})
+	_, err := client.DownloadFileAsReader(context.Background(), server.URL+"/195174666")
+	r.True(errors.Is(err, ErrNotFound), "Expected ErrNotFound, got %v", err)
+
+	nf := &NotFoundError{}
+	r.True(errors.As(err, &nf))
+	r.Equal(http.StatusNotFound, nf.Err.HTTPStatusCode)
+}
+
+func TestGetFilesByScope(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"file_id": 195174666, "mimetype": "image/jpeg", "created_on": "2016-06-30 15:38:47"}]`))
+	})
+
+	files, err := client.GetFilesByItem(context.Background(), 582709679, nil)
+	r.NoError(err)
+	r.Len(files, 1)
+	r.Equal("image/jpeg", files[0].MimeType)
+	r.Equal(*parseTime(t, "2016-06-30 15:38:47"), files[0].CreatedOn)
+
+	_, err = client.GetFilesByApp(context.Background(), 18166054, &FileListOptions{Limit: 10})
+	r.NoError(err)
+
+	_, err = client.GetFilesBySpace(context.Background(), 2720177, &FileListOptions{Limit: 10, Offset: 20})
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/file/item/582709679/",
+		"/file/app/18166054/?limit=10",
+		"/file/space/2720177/?limit=10&offset=20",
+	}, urls)
+}
+
+func TestGetFilesCancelled(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s", req.URL.Path)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetFilesWithOptions(ctx, nil)
+	r.True(errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+	_, err = client.GetFilesByItem(ctx, 582709679, nil)
+	r.True(errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+}
+
+func TestGetFilesWithOptions(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"file_id": 195174666, "attached": true, "attached_to": {"ref_type": "item", "ref_id": 582709679}}]`))
+	})
+
+	files, err := client.GetFilesWithOptions(context.Background(), nil)
+	r.NoError(err)
+	r.Len(files, 1)
+	r.True(files[0].Attached)
+	r.Equal(&FileRef{RefType: "item", RefId: 582709679}, files[0].AttachedTo)
+
+	spaceId := int64(2720177)
+	for _, opts := range []*FileListOptions{
+		{Limit: 10},
+		{Offset: 20},
+		{MimeType: "image/png"},
+		{Attached: true},
+		{SpaceId: &spaceId},
+		{Limit: 10, Offset: 20, MimeType: "image/png", Attached: true, SpaceId: &spaceId},
+	} {
+		_, err = client.GetFilesWithOptions(context.Background(), opts)
+		r.NoError(err)
+	}
+
+	r.Equal([]string{
+		"/file/",
+		"/file/?limit=10",
+		"/file/?offset=20",
+		"/file/?mimetype=image%2Fpng",
+		"/file/?attached=true",
+		"/file/?space_id=2720177",
+		"/file/?attached=true&limit=10&mimetype=image%2Fpng&offset=20&space_id=2720177",
+	}, urls)
+}
+
+// zeroReader produces n zero bytes without allocating them up front
+type zeroReader struct {
+	n int64
+}
+
+func (z *zeroReader) Read(p []byte) (int, error) {
+	if z.n <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > z.n {
+		p = p[:z.n]
+	}
+	for i := range p {
+		p[i] = 0
+	}
+	z.n -= int64(len(p))
+	return len(p), nil
+}
+
+func TestCreateFileFromReader(t *testing.T) {
+	r := require.New(t)
+
+	const size = 10 << 20
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/file", req.URL.Path)
+		r.True(req.ContentLength > size, "Expected Content-Length to include the file, is %d", req.ContentLength)
+
+		reader, err := req.MultipartReader()
+		r.NoError(err)
+
+		part, err := reader.NextPart()
+		r.NoError(err)
+		r.Equal("filename", part.FormName())
+		filename, err := ioutil.ReadAll(part)
+		r.NoError(err)
+		r.Equal("big.bin", string(filename))
+
+		part, err = reader.NextPart()
+		r.NoError(err)
+		r.Equal("source", part.FormName())
+		r.Equal("big.bin", part.FileName())
+		n, err := io.Copy(ioutil.Discard, part)
+		r.NoError(err)
+		r.Equal(int64(size), n)
+
+		_, err = reader.NextPart()
+		r.Equal(io.EOF, err)
+
+		w.Write([]byte(`{"file_id": 195174667, "name": "big.bin", "size": 10485760}`))
+	})
+
+	file, err := client.CreateFileFromReader(context.Background(), "big.bin", size, &zeroReader{size})
+	r.NoError(err)
+	r.Equal(int64(195174667), file.Id)
+	r.Equal(size, file.Size)
+}
+
+func TestCreateFileFromReaderUnknownSize(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal(int64(-1), req.ContentLength)
+
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		r.NoError(err)
+		form, err := multipart.NewReader(req.Body, params["boundary"]).ReadForm(1 << 20)
+		r.NoError(err)
+		r.Equal([]string{"small.txt"}, form.Value["filename"])
+
+		w.Write([]byte(`{"file_id": 195174668}`))
+	})
+
+	file, err := client.CreateFileFromReader(context.Background(), "small.txt", 0, strings.NewReader("hello"))
+	r.NoError(err)
+	r.Equal(int64(195174668), file.Id)
+}
@@ -0,0 +1,226 @@
+package podio
+
+import (
+	"fmt"
+	"time"
+)
+
+// ItemFilterRequest is the body of a filter request for the items of an app.
+// Use Params to pass it to FilterItems or GetItemsWithView.
+//
+// https://developers.podio.com/doc/items/filter-items-4496747
+type ItemFilterRequest struct {
+	Filters  map[string]interface{} `json:"filters,omitempty"`
+	SortBy   string                 `json:"sort_by,omitempty"`
+	SortDesc bool                   `json:"sort_desc,omitempty"`
+	Limit    int                    `json:"limit,omitempty"`
+	Offset   int                    `json:"offset,omitempty"`
+}
+
+// Params returns the filter request as parameters for FilterItems.
+func (r *ItemFilterRequest) Params() map[string]interface{} {
+	params := map[string]interface{}{}
+	if len(r.Filters) > 0 {
+		params["filters"] = r.Filters
+	}
+	if r.SortBy != "" {
+		params["sort_by"] = r.SortBy
+		params["sort_desc"] = r.SortDesc
+	}
+	if r.Limit > 0 {
+		params["limit"] = r.Limit
+	}
+	if r.Offset > 0 {
+		params["offset"] = r.Offset
+	}
+	return params
+}
+
+// filterCondition is a single condition added to a FilterBuilder
+type filterCondition struct {
+	key      string
+	operator string
+	value    interface{}
+}
+
+// FilterBuilder constructs an ItemFilterRequest:
+//
+//	req, err := NewFilterBuilder().
+//		Where("status", "=", []int{1}).
+//		And("created_on", ">=", since).
+//		SortBy("created_on", true).
+//		Limit(50).
+//		Build()
+//
+// The key of a condition is a field id or external id, or one of the item
+// properties Podio can filter on (created_on, created_by, ...).
+//
+// The operators are "=" for an exact match, and ">", ">=", "<" and "<=" for
+// ranges. Podio ranges are inclusive, so ">=" and "<=" give the "from" and "to"
+// of the range as they are, while ">" and "<" move the bound by one: one for
+// integers, and one second, the precision of Podio times, for times. Strict
+// operators on other values, such as floats, make Build return an error.
+//
+// A FilterBuilder has value semantics; every method returns a modified copy and
+// leaves the receiver untouched.
+type FilterBuilder struct {
+	conditions []filterCondition
+	sortBy     string
+	sortDesc   bool
+	limit      int
+	offset     int
+	err        error
+}
+
+// NewFilterBuilder returns an empty FilterBuilder
+func NewFilterBuilder() FilterBuilder {
+	return FilterBuilder{}
+}
+
+// Where adds a condition to the filter. An unknown operator makes Build return an error.
+// A nil *Time is an unset optional bound and adds no condition.
+func (b FilterBuilder) Where(key, operator string, value interface{}) FilterBuilder {
+	switch operator {
+	case "=", ">", ">=", "<", "<=":
+	default:
+		if b.err == nil {
+			b.err = fmt.Errorf("podio: unknown filter operator %q on %q", operator, key)
+		}
+		return b
+	}
+
+	if t, ok := value.(*Time); ok && t == nil {
+		return b
+	}
+
+	if operator == ">" || operator == "<" {
+		bound, ok := exclusiveBound(value, operator == ">")
+		if !ok {
+			if b.err == nil {
+				b.err = fmt.Errorf("podio: filter operator %q on %q needs an integer or a time, got %T", operator, key, value)
+			}
+			return b
+		}
+		operator, value = operator+"=", bound
+	}
+
+	// copy on append so builders derived from the same value do not share conditions
+	b.conditions = append(b.conditions[:len(b.conditions):len(b.conditions)], filterCondition{key, operator, value})
+	return b
+}
+
+// And is the same as Where. It reads better when chaining multiple conditions.
+func (b FilterBuilder) And(key, operator string, value interface{}) FilterBuilder {
+	return b.Where(key, operator, value)
+}
+
+// SortBy sorts the items by the given field or property, descending if desc is true.
+func (b FilterBuilder) SortBy(key string, desc bool) FilterBuilder {
+	b.sortBy, b.sortDesc = key, desc
+	return b
+}
+
+// Limit sets the maximum number of items to return
+func (b FilterBuilder) Limit(limit int) FilterBuilder {
+	b.limit = limit
+	return b
+}
+
+// Offset sets the number of items to skip
+func (b FilterBuilder) Offset(offset int) FilterBuilder {
+	b.offset = offset
+	return b
+}
+
+// Build returns the filter request, or the first error encountered while building it.
+func (b FilterBuilder) Build() (*ItemFilterRequest, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	req := &ItemFilterRequest{
+		SortBy:   b.sortBy,
+		SortDesc: b.sortDesc,
+		Limit:    b.limit,
+		Offset:   b.offset,
+	}
+
+	if len(b.conditions) == 0 {
+		return req, nil
+	}
+
+	req.Filters = map[string]interface{}{}
+	for _, c := range b.conditions {
+		value := filterValue(c.value)
+		if c.operator == "=" {
+			req.Filters[c.key] = value
+			continue
+		}
+
+		rng, ok := req.Filters[c.key].(map[string]interface{})
+		if !ok {
+			rng = map[string]interface{}{}
+			req.Filters[c.key] = rng
+		}
+
+		if c.operator == ">=" {
+			rng["from"] = value
+		} else {
+			rng["to"] = value
+		}
+	}
+
+	return req, nil
+}
+
+// exclusiveBound returns the inclusive bound for a strict comparison with value: the next
+// integer or second after value if above is true, the one before it otherwise. It
+// returns false for values that are neither integers nor times.
+func exclusiveBound(value interface{}, above bool) (interface{}, bool) {
+	step := -1
+	if above {
+		step = 1
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v + step, true
+	case int32:
+		return v + int32(step), true
+	case int64:
+		return v + int64(step), true
+	case time.Time:
+		return exclusiveTimeBound(v, above), true
+	case Time:
+		return Time{exclusiveTimeBound(v.Time, above)}, true
+	case *Time:
+		return &Time{exclusiveTimeBound(v.Time, above)}, true
+	}
+	return nil, false
+}
+
+// exclusiveTimeBound returns the first whole second after t if above is true, and the
+// last whole second before t otherwise
+func exclusiveTimeBound(t time.Time, above bool) time.Time {
+	second := t.Truncate(time.Second)
+	if above {
+		return second.Add(time.Second)
+	}
+	if second.Equal(t) {
+		return second.Add(-time.Second)
+	}
+	return second
+}
+
+// filterValue converts times to the format Podio expects in filters, in UTC
+func filterValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(podioLayout)
+	case Time:
+		return v.UTC().Format(podioLayout)
+	case *Time:
+		return v.UTC().Format(podioLayout)
+	}
+	return value
+}
@@ -0,0 +1,146 @@
+package podio
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterBuilderCompound(t *testing.T) {
+	r := require.New(t)
+
+	since := time.Date(2017, 3, 21, 23, 29, 7, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+
+	req, err := NewFilterBuilder().
+		Where("status", "=", []int{1, 2}).
+		And("created_on", ">", since).
+		And("created_on", "<=", until).
+		SortBy("created_on", true).
+		Limit(50).
+		Offset(100).
+		Build()
+	r.NoError(err)
+
+	buf, err := json.Marshal(req)
+	r.NoError(err)
+	r.JSONEq(`{
+		"filters": {
+			"status": [1, 2],
+			"created_on": {"from": "2017-03-21 23:29:08", "to": "2017-03-22 23:29:07"}
+		},
+		"sort_by": "created_on",
+		"sort_desc": true,
+		"limit": 50,
+		"offset": 100
+	}`, string(buf))
+}
+
+func TestFilterBuilderSortAscending(t *testing.T) {
+	r := require.New(t)
+
+	req, err := NewFilterBuilder().SortBy("title", false).Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"sort_by": "title", "sort_desc": false}, req.Params())
+}
+
+func TestFilterBuilderEmpty(t *testing.T) {
+	r := require.New(t)
+
+	req, err := NewFilterBuilder().Build()
+	r.NoError(err)
+	r.Equal(&ItemFilterRequest{}, req)
+	r.Empty(req.Params())
+
+	buf, err := json.Marshal(req)
+	r.NoError(err)
+	r.Equal(`{}`, string(buf))
+}
+
+func TestFilterBuilderInvalidOperator(t *testing.T) {
+	r := require.New(t)
+
+	_, err := NewFilterBuilder().Where("status", "!=", 1).And("title", "=", "a").Build()
+	r.EqualError(err, `podio: unknown filter operator "!=" on "status"`)
+}
+
+func TestFilterBuilderStrictOperators(t *testing.T) {
+	r := require.New(t)
+
+	// Podio ranges include their bounds, so strict bounds are moved past the value
+	req, err := NewFilterBuilder().Where("priority", ">", 5).And("priority", "<", int64(10)).Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"from": 6, "to": int64(9)}, req.Filters["priority"])
+
+	req, err = NewFilterBuilder().Where("priority", ">=", 5).And("priority", "<=", 10).Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"from": 5, "to": 10}, req.Filters["priority"])
+
+	since := time.Date(2017, 3, 21, 23, 29, 7, 0, time.UTC)
+	req, err = NewFilterBuilder().Where("created_on", ">", since).And("created_on", "<", since).Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"from": "2017-03-21 23:29:08", "to": "2017-03-21 23:29:06"}, req.Filters["created_on"])
+
+	// a time between whole seconds is bounded by the seconds around it
+	req, err = NewFilterBuilder().
+		Where("created_on", ">", Time{since.Add(500 * time.Millisecond)}).
+		And("created_on", "<", &Time{since.Add(500 * time.Millisecond)}).
+		Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"from": "2017-03-21 23:29:08", "to": "2017-03-21 23:29:07"}, req.Filters["created_on"])
+
+	_, err = NewFilterBuilder().Where("amount", ">", 2.5).Build()
+	r.EqualError(err, `podio: filter operator ">" on "amount" needs an integer or a time, got float64`)
+}
+
+func TestFilterBuilderTimes(t *testing.T) {
+	r := require.New(t)
+
+	// times are sent in UTC, whatever their location
+	cet := time.FixedZone("CET", 3600)
+	since := time.Date(2017, 3, 22, 0, 29, 7, 0, cet)
+	req, err := NewFilterBuilder().
+		Where("created_on", ">=", Time{since}).
+		And("last_event_on", "<=", &Time{since}).
+		And("due_on", ">", Time{since}).
+		Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"from": "2017-03-21 23:29:07"}, req.Filters["created_on"])
+	r.Equal(map[string]interface{}{"to": "2017-03-21 23:29:07"}, req.Filters["last_event_on"])
+	r.Equal(map[string]interface{}{"from": "2017-03-21 23:29:08"}, req.Filters["due_on"])
+
+	// an unset optional bound adds no condition
+	var until *Time
+	req, err = NewFilterBuilder().
+		Where("created_on", ">=", Time{since}).
+		And("created_on", "<", until).
+		And("created_on", "<=", until).
+		Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"from": "2017-03-21 23:29:07"}, req.Filters["created_on"])
+
+	_, err = NewFilterBuilder().Where("created_on", "!=", until).Build()
+	r.EqualError(err, `podio: unknown filter operator "!=" on "created_on"`)
+}
+
+func TestFilterBuilderValueSemantics(t *testing.T) {
+	r := require.New(t)
+
+	base := NewFilterBuilder().Where("status", "=", 1)
+	open := base.And("title", "=", "open")
+	closed := base.And("title", "=", "closed")
+
+	baseReq, err := base.Build()
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"status": 1}, baseReq.Filters)
+
+	openReq, err := open.Build()
+	r.NoError(err)
+	r.Equal("open", openReq.Filters["title"])
+
+	closedReq, err := closed.Build()
+	r.NoError(err)
+	r.Equal("closed", closedReq.Filters["title"])
+}
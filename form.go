@@ -0,0 +1,52 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// Form is a web form through which people outside Podio can add items to an app
+type Form struct {
+	Id       int64                  `json:"form_id"`
+	AppId    int64                  `json:"app_id"`
+	Status   string                 `json:"status"` // active or disabled
+	Settings map[string]interface{} `json:"settings"`
+	Domains  []string               `json:"domains"` // the domains the form may be embedded on
+	Fields   []FormField            `json:"fields"`
+}
+
+// FormField is an app field shown in a web form
+type FormField struct {
+	FieldId  int64                  `json:"field_id"`
+	Settings map[string]interface{} `json:"settings"`
+}
+
+// https://developers.podio.com/doc/forms/get-forms-53771
+func (client *Client) GetForms(ctx context.Context, appId int64) (forms []*Form, err error) {
+	path := fmt.Sprintf("/form/app/%d/", appId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &forms)
+	return
+}
+
+// https://developers.podio.com/doc/forms/get-form-53754
+func (client *Client) GetForm(ctx context.Context, formId int64) (form *Form, err error) {
+	path := fmt.Sprintf("/form/%d", formId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &form)
+	return
+}
+
+// ActivateForm makes a form accept submissions again after DeactivateForm
+//
+// https://developers.podio.com/doc/forms/activate-form-1107439
+func (client *Client) ActivateForm(ctx context.Context, formId int64) error {
+	path := fmt.Sprintf("/form/%d/activate", formId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// DeactivateForm stops a form from accepting submissions
+//
+// https://developers.podio.com/doc/forms/deactivate-form-1107378
+func (client *Client) DeactivateForm(ctx context.Context, formId int64) error {
+	path := fmt.Sprintf("/form/%d/deactivate", formId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
@@ -0,0 +1,70 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const formJSON = `{
+	"form_id": 93947,
+	"app_id": 18166054,
+	"status": "active",
+	"settings": {"captcha": true, "text": {"submit": "Send"}},
+	"domains": ["example.com"],
+	"fields": [{"field_id": 142837427, "settings": {"required": true}}]
+}`
+
+func TestGetForms(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/form/app/18166054/", req.URL.Path)
+		w.Write([]byte(`[` + formJSON + `]`))
+	})
+
+	forms, err := client.GetForms(context.Background(), 18166054)
+	r.NoError(err)
+	r.Equal([]*Form{{
+		Id:       93947,
+		AppId:    18166054,
+		Status:   "active",
+		Settings: map[string]interface{}{"captcha": true, "text": map[string]interface{}{"submit": "Send"}},
+		Domains:  []string{"example.com"},
+		Fields:   []FormField{{FieldId: 142837427, Settings: map[string]interface{}{"required": true}}},
+	}}, forms)
+}
+
+func TestGetForm(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/form/93947", req.URL.Path)
+		w.Write([]byte(formJSON))
+	})
+
+	form, err := client.GetForm(context.Background(), 93947)
+	r.NoError(err)
+	r.Equal(int64(93947), form.Id)
+	r.Equal("active", form.Status)
+	r.Len(form.Fields, 1)
+}
+
+func TestActivateAndDeactivateForm(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	r.NoError(client.DeactivateForm(ctx, 93947))
+	r.NoError(client.ActivateForm(ctx, 93947))
+	r.Equal([]string{"POST /form/93947/deactivate", "POST /form/93947/activate"}, requests)
+}
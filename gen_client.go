@@ -0,0 +1,283 @@
+//go:build ignore
+
+// gen_client generates ClientInterface in client_interface.go and the RecordingClient
+// in podiotest/recording_client.go from the exported methods of *Client.
+// Run it with go generate after adding or changing methods of *Client.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// method is an exported method of *Client
+type method struct {
+	name    string
+	params  []*ast.Field
+	results []*ast.Field
+	file    string
+	pos     token.Pos
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		name := info.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != "gen_client.go" && name != "client_interface.go"
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	methods := []*method{}
+	imports := map[string]string{} // package name to import path
+	for _, file := range pkgs["podio"].Files {
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := path.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			if recv, ok := star.X.(*ast.Ident); !ok || recv.Name != "Client" {
+				continue
+			}
+
+			m := &method{name: fn.Name.Name, params: fn.Type.Params.List, file: fset.File(fn.Pos()).Name(), pos: fn.Pos()}
+			if fn.Type.Results != nil {
+				m.results = fn.Type.Results.List
+			}
+			methods = append(methods, m)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		if methods[i].file != methods[j].file {
+			return methods[i].file < methods[j].file
+		}
+		return methods[i].pos < methods[j].pos
+	})
+
+	write("client_interface.go", clientInterface(fset, methods, imports))
+	write("podiotest/recording_client.go", recordingClient(fset, methods, imports))
+}
+
+func write(filename string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("%s: %v\n%s", filename, err, src)
+	}
+	if err := ioutil.WriteFile(filename, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// typeString prints a type expression, prefixing the exported identifiers of the
+// podio package with qualifier
+func typeString(fset *token.FileSet, expr ast.Expr, qualifier string, selectors map[string]bool) string {
+	var qualify func(expr ast.Expr) ast.Expr
+	qualify = func(expr ast.Expr) ast.Expr {
+		switch t := expr.(type) {
+		case *ast.Ident:
+			if t.IsExported() {
+				return &ast.Ident{Name: qualifier + t.Name}
+			}
+		case *ast.SelectorExpr:
+			selectors[t.X.(*ast.Ident).Name] = true
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: qualify(t.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: t.Len, Elt: qualify(t.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: qualify(t.Key), Value: qualify(t.Value)}
+		case *ast.ChanType:
+			return &ast.ChanType{Dir: t.Dir, Value: qualify(t.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: qualify(t.Elt)}
+		case *ast.FuncType:
+			return &ast.FuncType{Params: qualifyFields(t.Params, qualify), Results: qualifyFields(t.Results, qualify)}
+		}
+		return expr
+	}
+
+	buf := &bytes.Buffer{}
+	printer.Fprint(buf, fset, qualify(expr))
+	return buf.String()
+}
+
+func qualifyFields(fields *ast.FieldList, qualify func(ast.Expr) ast.Expr) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	list := &ast.FieldList{}
+	for _, field := range fields.List {
+		list.List = append(list.List, &ast.Field{Names: field.Names, Type: qualify(field.Type)})
+	}
+	return list
+}
+
+// param is a single parameter or result of a method
+type param struct {
+	name string
+	typ  string
+}
+
+func flatten(fset *token.FileSet, fields []*ast.Field, prefix, qualifier string, selectors map[string]bool) []param {
+	params := []param{}
+	for _, field := range fields {
+		typ := typeString(fset, field.Type, qualifier, selectors)
+
+		names := []string{}
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		if len(names) == 0 {
+			names = []string{""}
+		}
+		for _, name := range names {
+			if name == "" || name == "_" {
+				name = fmt.Sprintf("%s%d", prefix, len(params))
+			}
+			params = append(params, param{name: name, typ: typ})
+		}
+	}
+	return params
+}
+
+func signature(params, results []param) string {
+	in := []string{}
+	for _, p := range params {
+		in = append(in, p.name+" "+p.typ)
+	}
+	out := []string{}
+	for _, r := range results {
+		out = append(out, r.typ)
+	}
+
+	sig := "(" + strings.Join(in, ", ") + ")"
+	switch len(out) {
+	case 0:
+	case 1:
+		sig += " " + out[0]
+	default:
+		sig += " (" + strings.Join(out, ", ") + ")"
+	}
+	return sig
+}
+
+const header = "// Code generated by gen_client.go; DO NOT EDIT.\n\n"
+
+func clientInterface(fset *token.FileSet, methods []*method, imports map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	selectors := map[string]bool{}
+
+	body := &bytes.Buffer{}
+	file := ""
+	for _, m := range methods {
+		if m.file != file {
+			if file != "" {
+				body.WriteString("\n")
+			}
+			fmt.Fprintf(body, "\t// %s\n", m.file)
+			file = m.file
+		}
+		params := flatten(fset, m.params, "p", "", selectors)
+		results := flatten(fset, m.results, "r", "", selectors)
+		fmt.Fprintf(body, "\t%s%s\n", m.name, signature(params, results))
+	}
+
+	buf.WriteString(header)
+	buf.WriteString("package podio\n\n")
+	writeImports(buf, selectors, imports)
+	buf.WriteString("// ClientInterface declares the exported methods of *Client, so code using a client\n")
+	buf.WriteString("// can be given a test double such as podiotest.RecordingClient instead.\n")
+	buf.WriteString("type ClientInterface interface {\n")
+	buf.Write(body.Bytes())
+	buf.WriteString("}\n\nvar _ ClientInterface = (*Client)(nil)\n")
+	return buf.Bytes()
+}
+
+func recordingClient(fset *token.FileSet, methods []*method, imports map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	selectors := map[string]bool{}
+
+	body := &bytes.Buffer{}
+	for _, m := range methods {
+		params := flatten(fset, m.params, "p", "podio.", selectors)
+		results := flatten(fset, m.results, "r", "podio.", selectors)
+
+		args := []string{}
+		for _, p := range params {
+			args = append(args, p.name)
+		}
+
+		fmt.Fprintf(body, "\nfunc (c *RecordingClient) %s%s {\n", m.name, signature(params, results))
+		if len(results) == 0 {
+			fmt.Fprintf(body, "\tc.record(%q, []interface{}{%s}, 0)\n}\n", m.name, strings.Join(args, ", "))
+			continue
+		}
+
+		fmt.Fprintf(body, "\tret := c.record(%q, []interface{}{%s}, %d)\n", m.name, strings.Join(args, ", "), len(results))
+		names := []string{}
+		for i, r := range results {
+			name := fmt.Sprintf("r%d", i)
+			names = append(names, name)
+			fmt.Fprintf(body, "\t%s, ok := ret[%d].(%s)\n", name, i, r.typ)
+			fmt.Fprintf(body, "\tcheckReturn(%q, %d, ret[%d], ok, %q)\n", m.name, i, i, r.typ)
+		}
+		fmt.Fprintf(body, "\treturn %s\n}\n", strings.Join(names, ", "))
+	}
+
+	buf.WriteString(header)
+	buf.WriteString("package podiotest\n\n")
+	writeImports(buf, selectors, imports, "github.com/andreas/podio-go")
+	buf.WriteString("var _ podio.ClientInterface = (*RecordingClient)(nil)\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+func writeImports(buf *bytes.Buffer, selectors map[string]bool, imports map[string]string, extra ...string) {
+	paths := extra
+	for name := range selectors {
+		paths = append(paths, imports[name])
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		// standard library packages first
+		iStd, jStd := !strings.Contains(paths[i], "."), !strings.Contains(paths[j], ".")
+		if iStd != jStd {
+			return iStd
+		}
+		return paths[i] < paths[j]
+	})
+
+	buf.WriteString("import (\n")
+	for i, p := range paths {
+		if i > 0 && !strings.Contains(paths[i-1], ".") && strings.Contains(p, ".") {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(buf, "\t%q\n", p)
+	}
+	buf.WriteString(")\n\n")
+}
@@ -0,0 +1,75 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// GrantAction is the access a grant gives to an object
+type GrantAction string
+
+const (
+	GrantActionView    GrantAction = "view"
+	GrantActionComment GrantAction = "comment"
+	GrantActionRate    GrantAction = "rate"
+)
+
+// Grant gives a user access to a single object, e.g. an item, without membership of
+// its space
+type Grant struct {
+	Id        int64       `json:"grant_id"`
+	User      *Contact    `json:"user"`
+	Action    GrantAction `json:"action"`
+	Message   string      `json:"message"`
+	CreatedBy ByLine      `json:"created_by"`
+	CreatedOn Time        `json:"created_on"`
+}
+
+// GrantRequest describes who to grant access to an object and which access.
+// People who are not yet Podio users can be granted access by mail.
+type GrantRequest struct {
+	Action  GrantAction
+	Message string // personal message included in the notification
+	Users   []int64
+	Mails   []string
+}
+
+func (req *GrantRequest) params() map[string]interface{} {
+	people := []map[string]interface{}{}
+	for _, userId := range req.Users {
+		people = append(people, map[string]interface{}{"type": "user", "id": userId})
+	}
+	for _, mail := range req.Mails {
+		people = append(people, map[string]interface{}{"type": "mail", "id": mail})
+	}
+
+	params := map[string]interface{}{
+		"people": people,
+		"action": req.Action,
+	}
+	if req.Message != "" {
+		params["message"] = req.Message
+	}
+	return params
+}
+
+// https://developers.podio.com/doc/grants/get-grants-on-object-16491464
+func (client *Client) GetGrants(ctx context.Context, refType string, refId int64) (grants []*Grant, err error) {
+	path := fmt.Sprintf("/grant/%s/%d/", refType, refId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &grants)
+	return
+}
+
+// https://developers.podio.com/doc/grants/create-grant-16168841
+func (client *Client) CreateGrant(ctx context.Context, refType string, refId int64, req *GrantRequest) error {
+	path := fmt.Sprintf("/grant/%s/%d/", refType, refId)
+	return client.requestWithParamsContext(ctx, "POST", path, nil, req.params(), nil)
+}
+
+// RevokeGrant removes the access of a user to an object
+//
+// https://developers.podio.com/doc/grants/remove-grant-16496711
+func (client *Client) RevokeGrant(ctx context.Context, refType string, refId, userId int64) error {
+	path := fmt.Sprintf("/grant/%s/%d/%d", refType, refId, userId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
@@ -0,0 +1,77 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGrants(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/grant/item/582709679/", req.URL.Path)
+		w.Write([]byte(`[{
+			"grant_id": 5069,
+			"user": {"user_id": 2468975, "profile_id": 140798621, "name": "Brian Stengaard"},
+			"action": "comment",
+			"message": "Please have a look",
+			"created_by": {"id": 1, "type": "user", "name": "Podio"},
+			"created_on": "2017-03-21 23:29:07"
+		}]`))
+	})
+
+	grants, err := client.GetGrants(context.Background(), "item", 582709679)
+	r.NoError(err)
+	r.Equal([]*Grant{{
+		Id:        5069,
+		User:      &Contact{UserId: 2468975, ProfileId: 140798621, Name: "Brian Stengaard"},
+		Action:    GrantActionComment,
+		Message:   "Please have a look",
+		CreatedBy: ByLine{Id: 1, Type: "user", Name: "Podio"},
+		CreatedOn: *parseTime(t, "2017-03-21 23:29:07"),
+	}}, grants)
+}
+
+func TestCreateGrant(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/grant/item/582709679/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"action": "view",
+			"message": "FYI",
+			"people": [{"type": "user", "id": 2468975}, {"type": "mail", "id": "client@example.com"}]
+		}`, string(body))
+
+		w.Write([]byte(`{"ref_type": "item", "ref_id": 582709679}`))
+	})
+
+	err := client.CreateGrant(context.Background(), "item", 582709679, &GrantRequest{
+		Action:  GrantActionView,
+		Message: "FYI",
+		Users:   []int64{2468975},
+		Mails:   []string{"client@example.com"},
+	})
+	r.NoError(err)
+}
+
+func TestRevokeGrant(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/grant/item/582709679/2468975", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.RevokeGrant(context.Background(), "item", 582709679, 2468975))
+}
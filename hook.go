@@ -0,0 +1,173 @@
+package podio
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// HookEventType is the type of event a hook is triggered by
+//
+// https://developers.podio.com/doc/hooks
+type HookEventType string
+
+// Events available on hooks for apps
+const (
+	HookEventItemCreate     HookEventType = "item.create"
+	HookEventItemUpdate     HookEventType = "item.update"
+	HookEventItemDelete     HookEventType = "item.delete"
+	HookEventCommentCreate  HookEventType = "comment.create"
+	HookEventCommentDelete  HookEventType = "comment.delete"
+	HookEventFileChange     HookEventType = "file.change"
+	HookEventAppUpdate      HookEventType = "app.update"
+	HookEventAppDelete      HookEventType = "app.delete"
+	HookEventAppFieldCreate HookEventType = "app_field.create"
+	HookEventAppFieldUpdate HookEventType = "app_field.update"
+	HookEventAppFieldDelete HookEventType = "app_field.delete"
+	HookEventFormCreate     HookEventType = "form.create"
+	HookEventFormUpdate     HookEventType = "form.update"
+	HookEventFormDelete     HookEventType = "form.delete"
+	HookEventTagAdd         HookEventType = "tag.add"
+	HookEventTagDelete      HookEventType = "tag.delete"
+)
+
+// Events available on hooks for spaces
+const (
+	HookEventAppCreate    HookEventType = "app.create"
+	HookEventTaskCreate   HookEventType = "task.create"
+	HookEventTaskUpdate   HookEventType = "task.update"
+	HookEventTaskDelete   HookEventType = "task.delete"
+	HookEventMemberAdd    HookEventType = "member.add"
+	HookEventMemberRemove HookEventType = "member.remove"
+	HookEventRoleChange   HookEventType = "role.change"
+	HookEventStatusCreate HookEventType = "status.create"
+	HookEventStatusUpdate HookEventType = "status.update"
+	HookEventStatusDelete HookEventType = "status.delete"
+)
+
+// HookEventVerify is sent to a new hook to verify it
+const HookEventVerify HookEventType = "hook.verify"
+
+// Hook is a webhook on a Podio object. A new hook is inactive until it has been
+// verified with VerifyHook.
+type Hook struct {
+	Id     int64         `json:"hook_id"`
+	Type   HookEventType `json:"type"`
+	URL    string        `json:"url"`
+	Status string        `json:"status"` // active or inactive
+}
+
+// CreateHookRequest describes a new hook: the URL Podio posts to when the event occurs
+type CreateHookRequest struct {
+	URL  string
+	Type HookEventType
+}
+
+// GetHooks returns the hooks on an object, e.g. an app or a space.
+//
+// https://developers.podio.com/doc/hooks/get-hooks-215285
+func (client *Client) GetHooks(ctx context.Context, refType string, refId int64) (hooks []*Hook, err error) {
+	path := fmt.Sprintf("/hook/%s/%d/", refType, refId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &hooks)
+	return
+}
+
+// CreateHook adds a hook to an object and returns it. The hook is inactive: Podio sends
+// a verification code to the URL of the hook, which must be passed to VerifyHook to
+// activate it.
+//
+// https://developers.podio.com/doc/hooks/create-hook-215056
+func (client *Client) CreateHook(ctx context.Context, refType string, refId int64, req *CreateHookRequest) (*Hook, error) {
+	path := fmt.Sprintf("/hook/%s/%d/", refType, refId)
+	params := map[string]interface{}{
+		"url":  req.URL,
+		"type": req.Type,
+	}
+
+	rsp := &struct {
+		HookId int64 `json:"hook_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", path, nil, params, rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hook{Id: rsp.HookId, Type: req.Type, URL: req.URL, Status: "inactive"}, nil
+}
+
+// https://developers.podio.com/doc/hooks/delete-hook-215291
+func (client *Client) DeleteHook(ctx context.Context, hookId int64) error {
+	path := fmt.Sprintf("/hook/%d", hookId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// VerifyHook activates a hook with the code Podio sent to its URL in a hook.verify event.
+//
+// https://developers.podio.com/doc/hooks/validate-hook-verificated-215241
+func (client *Client) VerifyHook(ctx context.Context, hookId int64, code string) error {
+	path := fmt.Sprintf("/hook/%d/verify/validate", hookId)
+	params := map[string]interface{}{
+		"code": code,
+	}
+
+	return client.requestWithParamsContext(ctx, "POST", path, nil, params, nil)
+}
+
+// HookSignatureHeader is the header holding the signature of a hook request
+const HookSignatureHeader = "X-Podio-Signature"
+
+// ValidateHookSignature reports whether signature is the hex encoded HMAC-SHA256 of body
+// with the given secret.
+func ValidateHookSignature(secret, signature string, body []byte) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// HookMaxBodySize is the largest request body HookMiddleware reads. Hook requests are
+// small forms, and the body has to be read before the signature can be checked, so
+// larger bodies are rejected without reading them in full.
+const HookMaxBodySize = 1 << 20
+
+// HookMiddleware returns a middleware that passes on hook requests to the wrapped handler
+// only if their X-Podio-Signature header is valid for the secret, and responds with
+// 403 Forbidden otherwise, or 413 Request Entity Too Large if the body is larger than
+// HookMaxBodySize. The body of the request can be read again by the handler.
+//
+//	http.Handle("/podio", podio.HookMiddleware(secret)(handler))
+func HookMiddleware(secret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, HookMaxBodySize))
+			req.Body.Close()
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, "could not read request body", http.StatusBadRequest)
+				return
+			}
+
+			if !ValidateHookSignature(secret, req.Header.Get(HookSignatureHeader), body) {
+				http.Error(w, "invalid signature", http.StatusForbidden)
+				return
+			}
+
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, req)
+		})
+	}
+}
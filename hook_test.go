@@ -0,0 +1,118 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHooks(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/hook/app/18166054/", req.URL.Path)
+		w.Write([]byte(`[{"hook_id": 1457815, "type": "item.create", "url": "https://example.com/podio", "status": "active"}]`))
+	})
+
+	hooks, err := client.GetHooks(context.Background(), "app", 18166054)
+	r.NoError(err)
+	r.Equal([]*Hook{{Id: 1457815, Type: HookEventItemCreate, URL: "https://example.com/podio", Status: "active"}}, hooks)
+}
+
+func TestCreateHook(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/hook/app/18166054/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"url": "https://example.com/podio", "type": "item.update"}`, string(body))
+
+		w.Write([]byte(`{"hook_id": 1457816}`))
+	})
+
+	hook, err := client.CreateHook(context.Background(), "app", 18166054, &CreateHookRequest{URL: "https://example.com/podio", Type: HookEventItemUpdate})
+	r.NoError(err)
+	r.Equal(&Hook{Id: 1457816, Type: HookEventItemUpdate, URL: "https://example.com/podio", Status: "inactive"}, hook)
+}
+
+func TestDeleteHook(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/hook/1457816", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteHook(context.Background(), 1457816))
+}
+
+func TestVerifyHook(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/hook/1457816/verify/validate", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"code": "abcd1234"}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.VerifyHook(context.Background(), 1457816, "abcd1234"))
+}
+
+func TestValidateHookSignature(t *testing.T) {
+	r := require.New(t)
+
+	// test case 2 of RFC 4231
+	body := []byte("what do ya want for nothing?")
+	signature := "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+
+	r.True(ValidateHookSignature("Jefe", signature, body))
+	r.False(ValidateHookSignature("Jefe", signature, []byte("what do ya want for nothing!")))
+	r.False(ValidateHookSignature("jefe", signature, body))
+	r.False(ValidateHookSignature("Jefe", "not hex", body))
+	r.False(ValidateHookSignature("Jefe", "", body))
+}
+
+func TestHookMiddleware(t *testing.T) {
+	r := require.New(t)
+
+	var received []string
+	handler := HookMiddleware("Jefe")(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		received = append(received, string(body))
+	}))
+
+	send := func(body, signature string) int {
+		req := httptest.NewRequest("POST", "/podio", strings.NewReader(body))
+		req.Header.Set("X-Podio-Signature", signature)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	signature := "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+	r.Equal(http.StatusOK, send("what do ya want for nothing?", signature))
+	r.Equal(http.StatusForbidden, send("what do ya want for nothing!", signature))
+	r.Equal(http.StatusForbidden, send("what do ya want for nothing?", ""))
+	r.Equal([]string{"what do ya want for nothing?"}, received)
+
+	// bodies over the limit are rejected before the signature is checked
+	r.Equal(http.StatusForbidden, send(strings.Repeat("a", HookMaxBodySize), signature))
+	r.Equal(http.StatusRequestEntityTooLarge, send(strings.Repeat("a", HookMaxBodySize+1), signature))
+	r.Len(received, 1)
+}
@@ -1,8 +1,11 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 )
 
 // Item describes a Podio item object
@@ -22,6 +25,98 @@ type Item struct {
 	Revision           int      `json:"revision"`
 	Push               Push     `json:"push"`
 	ExternalId         string   `json:"external_id"`
+
+	CurrentRevision *ItemRevision `json:"current_revision"`
+	LastEventOn     Time          `json:"last_event_on"`
+}
+
+// ItemRevision describes a single revision of an item
+type ItemRevision struct {
+	Id         int64  `json:"item_revision_id"`
+	Revision   int    `json:"revision"`
+	CreatedBy  ByLine `json:"created_by"`
+	CreatedVia Via    `json:"created_via"`
+	CreatedOn  Time   `json:"created_on"`
+}
+
+// LastEditor returns who made the most recent revision of the item, and when the item
+// was last changed. It returns nil if the item was fetched without its current revision.
+func (item *Item) LastEditor() (*ByLine, Time) {
+	if item.CurrentRevision == nil {
+		return nil, item.LastEventOn
+	}
+	return &item.CurrentRevision.CreatedBy, item.LastEventOn
+}
+
+// Images returns the images of the item: the files in its image fields and the attached
+// files with an image/ mime type. Each file is returned once.
+func (item *Item) Images() []*File {
+	images := []*File{}
+	seen := map[int64]bool{}
+	add := func(file *File) {
+		if !seen[file.Id] {
+			seen[file.Id] = true
+			images = append(images, file)
+		}
+	}
+
+	for _, field := range item.Fields {
+		values, err := field.GetImageValues()
+		if err != nil {
+			continue
+		}
+		for i := range values {
+			add(&values[i].Value)
+		}
+	}
+	for _, file := range item.Files {
+		if strings.HasPrefix(file.MimeType, "image/") {
+			add(file)
+		}
+	}
+	return images
+}
+
+// GetFieldByID returns the field with the given field ID, or nil if the item has no such field.
+func (item *Item) GetFieldByID(id int64) *Field {
+	for _, field := range item.Fields {
+		if field.Id == id {
+			return field
+		}
+	}
+	return nil
+}
+
+// GetFieldByExternalID returns the first field with the given external ID, or nil
+// if the item has no such field. The comparison is case-sensitive.
+func (item *Item) GetFieldByExternalID(externalId string) *Field {
+	for _, field := range item.Fields {
+		if field.ExternalId == externalId {
+			return field
+		}
+	}
+	return nil
+}
+
+// MustGetFieldByExternalID is like GetFieldByExternalID but panics if the field is absent.
+// It is meant for code that knows the app schema up front.
+func (item *Item) MustGetFieldByExternalID(externalId string) *Field {
+	field := item.GetFieldByExternalID(externalId)
+	if field == nil {
+		panic(fmt.Sprintf("podio: item %d has no field with external id %q", item.Id, externalId))
+	}
+	return field
+}
+
+// GetFieldByLabel returns the first field with the given label, or nil if the item
+// has no such field. The comparison is case-sensitive.
+func (item *Item) GetFieldByLabel(label string) *Field {
+	for _, field := range item.Fields {
+		if field.Label == label {
+			return field
+		}
+	}
+	return nil
 }
 
 // partialField is used for JSON unmarshalling
@@ -292,6 +387,23 @@ type LocationFieldSettings struct {
 	HasMap     bool `json:"has_map"`
 }
 
+// LocationFieldValue is a single location parsed from a location field
+type LocationFieldValue = LocationValue
+
+// ParseLocationFieldValue returns the first location of a location field, or nil
+// if the field has no value.
+func ParseLocationFieldValue(field *Field) (*LocationFieldValue, error) {
+	return field.GetLocationValue()
+}
+
+// NewLocationFieldValue returns the value to use for a location field when creating
+// or updating an item. Podio geocodes the formatted address itself.
+func NewLocationFieldValue(formatted string) map[string]interface{} {
+	return map[string]interface{}{
+		"value": formatted,
+	}
+}
+
 // VideoValue is the value for fields of type `video`
 type VideoValue struct {
 	Value int `json:"value"`
@@ -405,11 +517,275 @@ func (client *Client) GetItems(appId int64) (items *ItemList, err error) {
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) FilterItems(appId int64, params map[string]interface{}) (items *ItemList, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files)", appId)
-	err = client.RequestWithParams("POST", path, nil, params, &items)
+	return client.GetItemsWithView(context.Background(), appId, params, ItemViewFull)
+}
+
+// GetItemsSorted returns a page of the items of an app sorted by a field or item property,
+// descending if sortDesc is true. A zero limit or offset uses the Podio default.
+func (client *Client) GetItemsSorted(ctx context.Context, appId int64, sortBy string, sortDesc bool, limit, offset int) (*ItemList, error) {
+	req := &ItemFilterRequest{
+		SortBy:   sortBy,
+		SortDesc: sortDesc,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	return client.GetItemsWithView(ctx, appId, req.Params(), ItemViewFull)
+}
+
+// GetItemsByPriority returns the first limit items of an app sorted by a priority or
+// ranking field, given by its external id. The highest values come first unless
+// ascending is true.
+func (client *Client) GetItemsByPriority(ctx context.Context, appId int64, priorityExternalId string, ascending bool, limit int) (*ItemList, error) {
+	return client.GetItemsSorted(ctx, appId, priorityExternalId, !ascending, limit, 0)
+}
+
+// GetItemsGroupedByCategory returns all items of an app grouped by the selected options
+// of a category field, given by its external id. The map is keyed by the option text.
+// Items with several selected options are in the group of each option; items without
+// a selected option are in the group with key "".
+func (client *Client) GetItemsGroupedByCategory(ctx context.Context, appId int64, categoryExternalId string) (map[string][]*Item, error) {
+	items, err := client.AllItems(ctx, appId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string][]*Item{}
+	for _, item := range items {
+		field := item.GetFieldByExternalID(categoryExternalId)
+		if field == nil {
+			groups[""] = append(groups[""], item)
+			continue
+		}
+
+		values, err := field.GetCategoryValues()
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 {
+			groups[""] = append(groups[""], item)
+		}
+		for _, value := range values {
+			groups[value.Value.Text] = append(groups[value.Value.Text], item)
+		}
+	}
+	return groups, nil
+}
+
+// Views of the items returned by GetItemsWithView.
+const (
+	ItemViewMicro = "micro"
+	ItemViewShort = "short"
+	ItemViewFull  = "full"
+)
+
+// GetItemsWithView filters the items of an app like FilterItems, but returns the items
+// in the given view. The full view includes the files of the items, the micro and
+// short views are cheaper representations for list renderers.
+//
+// https://developers.podio.com/doc/items/filter-items-4496747
+func (client *Client) GetItemsWithView(ctx context.Context, appId int64, params map[string]interface{}, view string) (items *ItemList, err error) {
+	fields := fmt.Sprintf("items.view(%s)", view)
+	if view == ItemViewFull {
+		fields = "items.fields(files)"
+	}
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, fields)
+	err = client.requestWithParamsContext(ctx, "POST", path, nil, params, &items)
 	return
 }
 
+// FilterItemsByView filters the items of an app using the filters and sorting of a saved view.
+//
+// https://developers.podio.com/doc/items/filter-items-by-view-4540284
+func (client *Client) FilterItemsByView(ctx context.Context, appId, viewId int64, params map[string]interface{}) (items *ItemList, err error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	path := fmt.Sprintf("/item/app/%d/filter/%d/?fields=items.fields(files)", appId, viewId)
+	err = client.requestWithParamsContext(ctx, "POST", path, nil, params, &items)
+	return
+}
+
+// GetItemCount returns the number of items in an app
+//
+// https://developers.podio.com/doc/items/get-item-count-34819997
+func (client *Client) GetItemCount(ctx context.Context, appId int64) (int, error) {
+	path := fmt.Sprintf("/item/app/%d/count", appId)
+
+	rsp := &struct {
+		Count int `json:"count"`
+	}{}
+	err := client.requestContext(ctx, "GET", path, nil, nil, rsp)
+
+	return rsp.Count, err
+}
+
+// GetItemCountsBySpace returns the number of items in each app of a space, keyed by
+// app id. The counts are fetched concurrently, a few apps at a time, and no more are
+// fetched once a request fails.
+func (client *Client) GetItemCountsBySpace(ctx context.Context, spaceId int64) (map[int64]int, error) {
+	apps, err := client.getAppsContext(ctx, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu     sync.Mutex
+		counts = make(map[int64]int, len(apps))
+	)
+
+	err = forEachConcurrently(len(apps), func(i int) error {
+		count, err := client.GetItemCount(ctx, apps[i].Id)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		counts[apps[i].Id] = count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// responsibleExternalId is the external id of the contact field apps conventionally use
+// for the person responsible for an item
+const responsibleExternalId = "responsible"
+
+// GetItemsAssignedToUser returns the items of an app where the contact field with the
+// external id "responsible" contains the user with the given profile id. Contact fields
+// hold profiles, so this is the profile id of the user (Contact.ProfileId), not the user
+// id. It returns an error if the app has no such field.
+func (client *Client) GetItemsAssignedToUser(ctx context.Context, appId, profileId int64) (*ItemList, error) {
+	fields, err := client.GetAppFields(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if field.ExternalId != responsibleExternalId {
+			continue
+		}
+
+		params := map[string]interface{}{
+			"filters": map[string]interface{}{
+				responsibleExternalId: []int64{profileId},
+			},
+		}
+		return client.GetItemsWithView(ctx, appId, params, ItemViewFull)
+	}
+
+	return nil, fmt.Errorf("podio: app %d has no field with external id %q", appId, responsibleExternalId)
+}
+
+// maxItemsLimit is the largest number of items Podio returns in a single filter request
+const maxItemsLimit = 500
+
+// AllItems returns all items of an app matching the filters in params. It
+// requests the items in pages of the largest size Podio allows, so any limit
+// and offset in params are ignored.
+func (client *Client) AllItems(ctx context.Context, appId int64, params map[string]interface{}) ([]*Item, error) {
+	return allItems(params, func(params map[string]interface{}) (*ItemList, error) {
+		return client.GetItemsWithView(ctx, appId, params, ItemViewFull)
+	})
+}
+
+// AllViewItemsFull returns all items in a saved view of an app, in the full item view.
+func (client *Client) AllViewItemsFull(ctx context.Context, appId, viewId int64) ([]*Item, error) {
+	return allItems(nil, func(params map[string]interface{}) (*ItemList, error) {
+		return client.FilterItemsByView(ctx, appId, viewId, params)
+	})
+}
+
+// allItems calls filter with increasing offsets until all filtered items have been fetched.
+func allItems(params map[string]interface{}, filter func(map[string]interface{}) (*ItemList, error)) ([]*Item, error) {
+	page := map[string]interface{}{}
+	for k, v := range params {
+		page[k] = v
+	}
+	page["limit"] = maxItemsLimit
+
+	items := []*Item{}
+	for {
+		page["offset"] = len(items)
+
+		list, err := filter(page)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, list.Items...)
+		if len(list.Items) == 0 || len(items) >= list.Filtered {
+			return items, nil
+		}
+	}
+}
+
+// GetItemsAsChannel streams the items of an app matching filter, which may be nil, without
+// holding them all in memory. The items are requested a page at a time, so the limit
+// and offset of filter are ignored, and sent on the item channel in order.
+//
+// Both channels are closed when all items have been sent, when a request fails or when
+// ctx is cancelled. In the latter two cases the error is sent on the error channel
+// first; no more than one error is sent. No more pages are requested once ctx is done.
+//
+// A caller that stops reading the item channel before it is closed must cancel ctx, or
+// the goroutine sending the items blocks forever and leaks.
+func (client *Client) GetItemsAsChannel(ctx context.Context, appId int64, filter *ItemFilterRequest) (<-chan *Item, <-chan error) {
+	items := make(chan *Item)
+	errc := make(chan error, 1)
+
+	page := map[string]interface{}{}
+	if filter != nil {
+		page = filter.Params()
+	}
+	page["limit"] = maxItemsLimit
+
+	go func() {
+		defer close(items)
+		defer close(errc)
+
+		sent := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+
+			page["offset"] = sent
+			list, err := client.GetItemsWithView(ctx, appId, page, ItemViewFull)
+			if err != nil {
+				errc <- err
+				return
+			}
+
+			for _, item := range list.Items {
+				select {
+				case items <- item:
+					sent++
+				case <-ctx.Done():
+					errc <- ctx.Err()
+					return
+				}
+			}
+
+			if len(list.Items) == 0 || sent >= list.Filtered {
+				return
+			}
+		}
+	}()
+
+	return items, errc
+}
+
 // https://developers.podio.com/doc/items/get-item-by-app-item-id-66506688
 func (client *Client) GetItemByAppItemId(appId int64, formattedAppItemId string) (item *Item, err error) {
 	path := fmt.Sprintf("/app/%d/item/%s", appId, formattedAppItemId)
@@ -426,11 +802,76 @@ func (client *Client) GetItemByExternalID(appId int64, externalId string) (item
 
 // https://developers.podio.com/doc/items/get-item-22360
 func (client *Client) GetItem(itemId int64) (item *Item, err error) {
+	return client.getItemContext(context.Background(), itemId)
+}
+
+// getItemContext is GetItem, but the request is aborted when ctx is cancelled
+func (client *Client) getItemContext(ctx context.Context, itemId int64) (item *Item, err error) {
 	path := fmt.Sprintf("/item/%d?fields=files", itemId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
+// GetItemWithCache returns an item like GetItem. If the client has an item cache (see
+// WithItemCache), the item is taken from the cache if present, and stored in it otherwise.
+// The returned item is then shared with later callers and must be treated as read-only.
+func (client *Client) GetItemWithCache(ctx context.Context, itemId int64) (*Item, error) {
+	if client.itemCache != nil {
+		if item, ok := client.itemCache.Get(itemId); ok {
+			return item, nil
+		}
+	}
+
+	item, err := client.getItemContext(ctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.itemCache != nil {
+		client.itemCache.Set(item)
+	}
+	return item, nil
+}
+
+// GetItemCreator returns the user or app that created an item, along with the time
+// it was created.
+//
+// https://developers.podio.com/doc/items/get-item-basic-61768
+func (client *Client) GetItemCreator(ctx context.Context, itemId int64) (*ByLine, Time, error) {
+	path := fmt.Sprintf("/item/%d/basic", itemId)
+
+	rsp := &struct {
+		CreatedBy ByLine `json:"created_by"`
+		CreatedOn Time   `json:"created_on"`
+	}{}
+	err := client.requestContext(ctx, "GET", path, nil, nil, rsp)
+	if err != nil {
+		return nil, Time{}, err
+	}
+	return &rsp.CreatedBy, rsp.CreatedOn, nil
+}
+
+// GetItemLastEditor returns the user or app that last edited an item, along with the
+// time it was last changed.
+func (client *Client) GetItemLastEditor(ctx context.Context, itemId int64) (*ByLine, Time, error) {
+	item, err := client.getItemContext(ctx, itemId)
+	if err != nil {
+		return nil, Time{}, err
+	}
+
+	editor, editedOn := item.LastEditor()
+	return editor, editedOn, nil
+}
+
+// GetItemImages returns the images in the image fields and attachments of an item.
+func (client *Client) GetItemImages(ctx context.Context, itemId int64) ([]*File, error) {
+	item, err := client.getItemContext(ctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+	return item.Images(), nil
+}
+
 // https://developers.podio.com/doc/items/add-new-item-22362
 func (client *Client) CreateItem(appId int, externalId string, fieldValues map[string]interface{}) (int64, error) {
 	path := fmt.Sprintf("/item/app/%d", appId)
@@ -457,5 +898,13 @@ func (client *Client) UpdateItem(itemId int, fieldValues map[string]interface{})
 		"fields": fieldValues,
 	}
 
+	defer client.forgetItem(int64(itemId))
 	return client.RequestWithParams("PUT", path, nil, params, nil)
 }
+
+// forgetItem removes an item changed through the client from the item cache, if any
+func (client *Client) forgetItem(itemId int64) {
+	if client.itemCache != nil {
+		client.itemCache.Delete(itemId)
+	}
+}
@@ -1,10 +1,15 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"reflect"
 
@@ -54,6 +59,389 @@ func TestUnmarshalAppValue(t *testing.T) {
 	r.Equal(values[0].Value.Id, int64(1))
 }
 
+func TestParseLocationFieldValue(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{}
+	err := json.Unmarshal(getFixtureJSON(t, "fixtures/item_582709679.json"), item)
+	r.NoError(err)
+
+	var field *Field
+	for _, f := range item.Fields {
+		if f.Type == "location" {
+			field = f
+		}
+	}
+	r.NotNil(field, "Expected fixture to have a location field")
+
+	loc, err := ParseLocationFieldValue(field)
+	r.NoError(err)
+	r.NotNil(loc)
+	r.Equal("Paris", loc.City)
+	r.Equal("75007", loc.PostalCode)
+	r.Equal(48.8583701, loc.Lat)
+	r.Equal(2.2944813, loc.Lng)
+
+	_, err = ParseLocationFieldValue(item.Fields[0])
+	r.Error(err)
+}
+
+func TestNewLocationFieldValue(t *testing.T) {
+	r := require.New(t)
+
+	buf, err := json.Marshal(NewLocationFieldValue("Champ de Mars, Paris"))
+	r.NoError(err)
+	r.Equal(`{"value":"Champ de Mars, Paris"}`, string(buf))
+}
+
+func TestGetField(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{Id: 42}
+	err := json.Unmarshal([]byte(`{"fields": [
+		{"field_id": 1, "external_id": "title", "label": "Title", "type": "text", "values": [{"value": "first"}]},
+		{"field_id": 2, "external_id": "status", "label": "Status", "type": "text", "values": [{"value": "open"}]},
+		{"field_id": 3, "external_id": "title", "label": "Other title", "type": "text", "values": [{"value": "second"}]}
+	]}`), item)
+	r.NoError(err)
+
+	r.Equal(int64(2), item.GetFieldByID(2).Id)
+	r.Nil(item.GetFieldByID(4))
+
+	r.Equal(int64(2), item.GetFieldByExternalID("status").Id)
+	r.Nil(item.GetFieldByExternalID("Status"))
+	r.Nil(item.GetFieldByExternalID("missing"))
+
+	// duplicate external ids resolve to the first field
+	r.Equal(int64(1), item.GetFieldByExternalID("title").Id)
+
+	r.Equal(int64(3), item.GetFieldByLabel("Other title").Id)
+	r.Nil(item.GetFieldByLabel("other title"))
+
+	r.Equal(int64(2), item.MustGetFieldByExternalID("status").Id)
+	r.PanicsWithValue(`podio: item 42 has no field with external id "missing"`, func() {
+		item.MustGetFieldByExternalID("missing")
+	})
+}
+
+func TestAllViewItemsFull(t *testing.T) {
+	r := require.New(t)
+
+	var offsets []float64
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/item/app/18166054/filter/31384275/", req.URL.Path)
+
+		params := map[string]interface{}{}
+		r.NoError(json.NewDecoder(req.Body).Decode(&params))
+		r.Equal(float64(500), params["limit"])
+
+		offset := params["offset"].(float64)
+		offsets = append(offsets, offset)
+
+		// 1200 items in the view, served 500 at a time
+		count := 500
+		if offset == 1000 {
+			count = 200
+		}
+		list := &ItemList{Filtered: 1200, Total: 5000}
+		for i := 0; i < count; i++ {
+			list.Items = append(list.Items, &Item{Id: int64(offset) + int64(i)})
+		}
+		json.NewEncoder(w).Encode(list)
+	})
+
+	items, err := client.AllViewItemsFull(context.Background(), 18166054, 31384275)
+	r.NoError(err)
+	r.Len(items, 1200)
+	r.Equal(int64(1199), items[1199].Id)
+	r.Equal([]float64{0, 500, 1000}, offsets)
+}
+
+func TestGetItemCreator(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/item/350017179/basic", req.URL.Path)
+		w.Write(getFixtureJSON(t, "fixtures/item_350017179.json"))
+	})
+
+	createdBy, createdOn, err := client.GetItemCreator(context.Background(), 350017179)
+	r.NoError(err)
+	r.Equal(int64(2468975), createdBy.Id)
+	r.Equal("user", createdBy.Type)
+	r.Equal("Brian Stengaard", createdBy.Name)
+	r.Equal(*parseTime(t, "2015-11-26 12:38:29"), createdOn)
+}
+
+func TestGetItemLastEditor(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/item/350017179", req.URL.Path)
+		w.Write(getFixtureJSON(t, "fixtures/item_350017179.json"))
+	})
+
+	editedBy, editedOn, err := client.GetItemLastEditor(context.Background(), 350017179)
+	r.NoError(err)
+	r.Equal(int64(2468975), editedBy.Id)
+	r.Equal("Brian Stengaard", editedBy.Name)
+	r.Equal(*parseTime(t, "2017-03-21 15:45:12"), editedOn)
+
+	editedBy, _ = (&Item{}).LastEditor()
+	r.Nil(editedBy)
+}
+
+func TestGetItemImages(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/item/582709679", req.URL.Path)
+		w.Write([]byte(`{
+			"item_id": 582709679,
+			"fields": [
+				{"field_id": 1, "type": "text", "external_id": "title", "values": [{"value": "Offer"}]},
+				{"field_id": 2, "type": "image", "external_id": "photos", "values": [
+					{"value": {"file_id": 10, "name": "front.png", "mimetype": "image/png"}},
+					{"value": {"file_id": 11, "name": "back.jpg", "mimetype": "image/jpeg"}}
+				]}
+			],
+			"files": [
+				{"file_id": 11, "name": "back.jpg", "mimetype": "image/jpeg"},
+				{"file_id": 12, "name": "offer.pdf", "mimetype": "application/pdf"},
+				{"file_id": 13, "name": "logo.gif", "mimetype": "image/gif"}
+			]
+		}`))
+	})
+
+	images, err := client.GetItemImages(context.Background(), 582709679)
+	r.NoError(err)
+
+	names := []string{}
+	for _, image := range images {
+		names = append(names, image.Name)
+	}
+	r.Equal([]string{"front.png", "back.jpg", "logo.gif"}, names)
+}
+
+func TestGetItemsSorted(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/item/app/18166054/filter", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"sort_by": "142837427", "sort_desc": true, "limit": 10, "offset": 20}`, string(body))
+
+		w.Write([]byte(`{"filtered": 30, "total": 30, "items": [{"item_id": 582709679}]}`))
+	})
+
+	list, err := client.GetItemsSorted(context.Background(), 18166054, "142837427", true, 10, 20)
+	r.NoError(err)
+	r.Equal(30, list.Filtered)
+	r.Len(list.Items, 1)
+}
+
+func TestGetItemsWithView(t *testing.T) {
+	r := require.New(t)
+
+	var fields, bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/item/app/18166054/filter", req.URL.Path)
+		fields = append(fields, req.URL.Query().Get("fields"))
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		bodies = append(bodies, string(body))
+
+		w.Write([]byte(`{"filtered": 1, "total": 1, "items": [{"item_id": 582709679, "title": "Offer"}]}`))
+	})
+
+	list, err := client.GetItemsWithView(context.Background(), 18166054, nil, ItemViewMicro)
+	r.NoError(err)
+	r.Len(list.Items, 1)
+	r.Equal("Offer", list.Items[0].Title)
+
+	_, err = client.GetItemsWithView(context.Background(), 18166054, map[string]interface{}{"limit": 5}, ItemViewShort)
+	r.NoError(err)
+	_, err = client.GetItemsWithView(context.Background(), 18166054, nil, ItemViewFull)
+	r.NoError(err)
+
+	r.Equal([]string{"items.view(micro)", "items.view(short)", "items.fields(files)"}, fields)
+	r.Equal([]string{`{}`, `{"limit":5}`, `{}`}, bodies)
+}
+
+func TestGetItemsByPriority(t *testing.T) {
+	r := require.New(t)
+
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		bodies = append(bodies, string(body))
+		w.Write([]byte(`{"filtered": 0, "total": 0, "items": []}`))
+	})
+
+	_, err := client.GetItemsByPriority(context.Background(), 18166054, "priority", false, 25)
+	r.NoError(err)
+	_, err = client.GetItemsByPriority(context.Background(), 18166054, "priority", true, 25)
+	r.NoError(err)
+
+	r.JSONEq(`{"sort_by": "priority", "sort_desc": true, "limit": 25}`, bodies[0])
+	r.JSONEq(`{"sort_by": "priority", "sort_desc": false, "limit": 25}`, bodies[1])
+}
+
+func TestGetItemsGroupedByCategory(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/item/app/18166054/filter", req.URL.Path)
+		category := func(texts ...string) string {
+			values := []string{}
+			for i, text := range texts {
+				values = append(values, fmt.Sprintf(`{"value": {"id": %d, "text": %q, "status": "active"}}`, i+1, text))
+			}
+			return fmt.Sprintf(`{"field_id": 142837427, "external_id": "status", "type": "category", "values": [%s]}`, strings.Join(values, ","))
+		}
+		w.Write([]byte(`{"filtered": 4, "items": [
+			{"item_id": 1, "fields": [` + category("Open") + `]},
+			{"item_id": 2, "fields": [` + category("Done") + `]},
+			{"item_id": 3, "fields": [` + category("Open", "Blocked") + `]},
+			{"item_id": 4, "fields": []}
+		]}`))
+	})
+
+	groups, err := client.GetItemsGroupedByCategory(context.Background(), 18166054, "status")
+	r.NoError(err)
+
+	ids := map[string][]int64{}
+	for text, items := range groups {
+		for _, item := range items {
+			ids[text] = append(ids[text], item.Id)
+		}
+	}
+	r.Equal(map[string][]int64{
+		"Open":    {1, 3},
+		"Done":    {2},
+		"Blocked": {3},
+		"":        {4},
+	}, ids)
+}
+
+func TestGetItemsAssignedToUser(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/app/18166054/field":
+			w.Write([]byte(`[{"field_id": 142837427, "external_id": "responsible", "type": "contact"}]`))
+		case "/app/18166055/field":
+			w.Write([]byte(`[{"field_id": 142837428, "external_id": "title", "type": "text"}]`))
+		case "/item/app/18166054/filter":
+			params := map[string]interface{}{}
+			r.NoError(json.NewDecoder(req.Body).Decode(&params))
+			r.Equal(map[string]interface{}{"responsible": []interface{}{float64(140798621)}}, params["filters"])
+			w.Write([]byte(`{"filtered": 1, "total": 3, "items": [{"item_id": 582709679}]}`))
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+		}
+	})
+
+	// the profile id of user 2468975
+	list, err := client.GetItemsAssignedToUser(context.Background(), 18166054, 140798621)
+	r.NoError(err)
+	r.Equal(1, list.Filtered)
+	r.Equal(int64(582709679), list.Items[0].Id)
+
+	_, err = client.GetItemsAssignedToUser(context.Background(), 18166055, 140798621)
+	r.EqualError(err, `podio: app 18166055 has no field with external id "responsible"`)
+}
+
+func TestGetItemCountsBySpace(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/app/space/2720177":
+			w.Write([]byte(`[{"app_id": 10}, {"app_id": 11}, {"app_id": 12}]`))
+		case "/item/app/10/count":
+			w.Write([]byte(`{"count": 42}`))
+		case "/item/app/11/count":
+			w.Write([]byte(`{"count": 0}`))
+		case "/item/app/12/count":
+			w.Write([]byte(`{"count": 1200}`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	counts, err := client.GetItemCountsBySpace(context.Background(), 2720177)
+	r.NoError(err)
+	r.Equal(map[int64]int{10: 42, 11: 0, 12: 1200}, counts)
+}
+
+func TestGetItemCountsBySpaceError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/app/space/2720177":
+			w.Write([]byte(`[{"app_id": 10}, {"app_id": 11}]`))
+		case "/item/app/10/count":
+			w.Write([]byte(`{"count": 42}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+		}
+	})
+
+	counts, err := client.GetItemCountsBySpace(context.Background(), 2720177)
+	r.Nil(counts)
+	r.EqualError(err, "forbidden: No access")
+}
+
+func TestGetItemCountsBySpaceStopsOnError(t *testing.T) {
+	r := require.New(t)
+
+	apps := []string{}
+	for i := 1; i <= 20; i++ {
+		apps = append(apps, fmt.Sprintf(`{"app_id": %d}`, i))
+	}
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/app/space/2720177" {
+			w.Write([]byte("[" + strings.Join(apps, ",") + "]"))
+			return
+		}
+
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		if req.URL.Path == "/item/app/1/count" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{"count": 1}`))
+	})
+
+	_, err := client.GetItemCountsBySpace(context.Background(), 2720177)
+	r.EqualError(err, "forbidden: No access")
+	r.True(calls < 20, "all %d apps were requested", calls)
+}
+
 // To add more tests using the fixtures simply generate the situation you are trying to
 // reproduce in Podio, fetch the results from https://developers.podio.com/ and add the JSON
 // data to the fixtures directory. This sidesteps the authentication dance in testing.
@@ -202,6 +590,7 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 							URL:      "https://podio.com/podio/sandbox-4fcx2i",
 							URLLabel: "sandbox-4fcx2i",
 							OrgId:    736,
+							Type:     "regular",
 						},
 						App: App{
 							Id:              10421272,
@@ -219,7 +608,21 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 							Icon:            "251.png",
 						},
 						CreatedVia: Via{Id: 1, Name: "Podio"},
-						CreatedOn:  *parseTime(t, "2015-10-09 12:49:43"),
+						CreatedBy: ByLine{
+							Id:         2468975,
+							Type:       "user",
+							Name:       "Brian Stengaard",
+							URL:        "https://podio.com/users/2468975",
+							AvatarType: "file",
+							AvatarId:   297530466,
+							Image: File{
+								Id:   297530466,
+								Link: "https://d2cmuesa4snpwn.cloudfront.net/public/297530466",
+							},
+							LastSeenOn: *parseTime(t, "2017-03-21 15:43:34"),
+							Avatar:     297530466,
+						},
+						CreatedOn: *parseTime(t, "2015-10-09 12:49:43"),
 					}}},
 					settings: AppFieldSettings{
 						Mulitple: true,
@@ -286,10 +689,11 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 				{ignore: "we already have a test for Number field"},
 				{
 					values: []ImageValue{{Value: File{
-						Id:   195174666,
-						Name: "a1.jpeg",
-						Link: "https://files.podio.com/195174666",
-						Size: 45326,
+						Id:       195174666,
+						Name:     "a1.jpeg",
+						Link:     "https://files.podio.com/195174666",
+						Size:     45326,
+						MimeType: "image/jpeg",
 					}}},
 					settings: ImageFieldSettings{[]string{"image/png"}},
 				},
@@ -386,3 +790,80 @@ func getFixtureJSON(t *testing.T, name string) []byte {
 
 	return buf
 }
+
+// itemPageHandler serves total items in pages as the filter endpoint does
+func itemPageHandler(t *testing.T, total int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		*requests++
+		params := struct {
+			Limit  int `json:"limit"`
+			Offset int `json:"offset"`
+		}{}
+		if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+			t.Error(err)
+		}
+
+		items := []string{}
+		for id := params.Offset + 1; id <= total && id <= params.Offset+params.Limit; id++ {
+			items = append(items, fmt.Sprintf(`{"item_id": %d}`, id))
+		}
+		fmt.Fprintf(w, `{"filtered": %d, "total": %d, "items": [%s]}`, total, total, strings.Join(items, ","))
+	}
+}
+
+func TestGetItemsAsChannel(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	client := newTestClient(t, itemPageHandler(t, 2*maxItemsLimit+1, &requests))
+
+	filter := &ItemFilterRequest{SortBy: "created_on", Limit: 10}
+	items, errc := client.GetItemsAsChannel(context.Background(), 18166054, filter)
+
+	ids := []int64{}
+	for item := range items {
+		ids = append(ids, item.Id)
+	}
+	r.NoError(<-errc)
+	r.Len(ids, 2*maxItemsLimit+1)
+	r.Equal(int64(1), ids[0])
+	r.Equal(int64(2*maxItemsLimit+1), ids[len(ids)-1])
+	r.Equal(3, requests)
+}
+
+func TestGetItemsAsChannelCancel(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	client := newTestClient(t, itemPageHandler(t, 3*maxItemsLimit, &requests))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	items, errc := client.GetItemsAsChannel(ctx, 18166054, nil)
+
+	for i := 0; i < 10; i++ {
+		<-items
+	}
+	cancel()
+
+	// the channels are closed without requesting another page
+	for range items {
+	}
+	r.Equal(context.Canceled, <-errc)
+	_, open := <-errc
+	r.False(open)
+	r.Equal(1, requests)
+}
+
+func TestGetItemsAsChannelError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+	})
+
+	items, errc := client.GetItemsAsChannel(context.Background(), 18166054, nil)
+	_, open := <-items
+	r.False(open)
+	r.EqualError(<-errc, "forbidden: No access")
+}
@@ -0,0 +1,119 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Notification is a notification of the authenticated user about an event on a Podio
+// object, e.g. a comment on an item. Data depends on the type of the notification.
+type Notification struct {
+	Id        int64                  `json:"notification_id"`
+	Type      string                 `json:"type"`
+	Text      string                 `json:"text"`
+	Ref       *Reference             `json:"ref"`
+	Data      map[string]interface{} `json:"data"`
+	Starred   bool                   `json:"starred"`
+	ViewedOn  *Time                  `json:"viewed_on"` // nil if the notification is unread
+	CreatedBy ByLine                 `json:"created_by"`
+	CreatedOn Time                   `json:"created_on"`
+}
+
+// Unread reports whether the user has not viewed the notification yet
+func (n *Notification) Unread() bool {
+	return n.ViewedOn == nil
+}
+
+// NotificationListOptions limits the notifications returned by GetNotifications.
+// Zero values are left out and the Podio defaults apply.
+type NotificationListOptions struct {
+	Unread bool   // only return unread notifications
+	Type   string // only return notifications of this type, e.g. comment
+	Limit  int
+	Offset int
+}
+
+func (opts *NotificationListOptions) query() string {
+	values := url.Values{}
+	if opts != nil {
+		if opts.Unread {
+			values.Set("viewed", "false")
+		}
+		if opts.Type != "" {
+			values.Set("type", opts.Type)
+		}
+		if opts.Limit > 0 {
+			values.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			values.Set("offset", strconv.Itoa(opts.Offset))
+		}
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// GetNotifications returns the notifications of the authenticated user, newest first.
+// opts may be nil.
+//
+// https://developers.podio.com/doc/notifications/get-notifications-290777
+func (client *Client) GetNotifications(ctx context.Context, opts *NotificationListOptions) (notifications []*Notification, err error) {
+	err = client.requestContext(ctx, "GET", "/notification/"+opts.query(), nil, nil, &notifications)
+	return
+}
+
+// MarkNotificationRead marks a notification as viewed
+//
+// https://developers.podio.com/doc/notifications/mark-notification-as-viewed-22436
+func (client *Client) MarkNotificationRead(ctx context.Context, notificationId int64) error {
+	path := fmt.Sprintf("/notification/%d/viewed", notificationId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// MarkAllNotificationsRead marks all notifications of the authenticated user as viewed
+//
+// https://developers.podio.com/doc/notifications/mark-all-notifications-as-viewed-58714
+func (client *Client) MarkAllNotificationsRead(ctx context.Context) error {
+	return client.requestContext(ctx, "POST", "/notification/viewed", nil, nil, nil)
+}
+
+// NotificationCount holds the notification counts of a user
+type NotificationCount struct {
+	New   int `json:"new"`   // notifications the user has not seen yet, as on the notification badge
+	Total int `json:"total"` // unread notifications, seen or not
+}
+
+// GetPersonalNotificationCount returns the number of new notifications of the user,
+// that is the number Podio shows on the notification badge. It is the New count of
+// GetNotificationCount.
+func (client *Client) GetPersonalNotificationCount(ctx context.Context) (int, error) {
+	count, err := client.GetNotificationCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return count.New, nil
+}
+
+// GetUnreadNotificationCount returns the number of unread notifications of the user, seen
+// or not. It is the Total count of GetNotificationCount; the New count is the number on
+// the notification badge, see GetPersonalNotificationCount.
+func (client *Client) GetUnreadNotificationCount(ctx context.Context) (int, error) {
+	count, err := client.GetNotificationCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return count.Total, nil
+}
+
+// GetNotificationCount returns the number of new and of unread notifications of the user.
+//
+// https://developers.podio.com/doc/notifications/get-notification-count-3918790
+func (client *Client) GetNotificationCount(ctx context.Context) (count *NotificationCount, err error) {
+	err = client.requestContext(ctx, "GET", "/notification/count", nil, nil, &count)
+	return
+}
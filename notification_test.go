@@ -0,0 +1,93 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNotifications(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{
+			"notification_id": 9153939952,
+			"type": "comment",
+			"text": "Brian Stengaard commented on Offer",
+			"ref": {"type": "item", "id": 582709679},
+			"data": {"comment_id": 616513651},
+			"viewed_on": null,
+			"created_by": {"id": 2468975, "type": "user", "name": "Brian Stengaard"},
+			"created_on": "2017-03-21 23:29:07"
+		}, {
+			"notification_id": 9153939953,
+			"type": "task",
+			"viewed_on": "2017-03-22 08:00:00"
+		}]`))
+	})
+
+	notifications, err := client.GetNotifications(context.Background(), nil)
+	r.NoError(err)
+	r.Len(notifications, 2)
+
+	n := notifications[0]
+	r.Equal(int64(9153939952), n.Id)
+	r.Equal("comment", n.Type)
+	r.Equal("item", n.Ref.Type)
+	r.Equal(float64(616513651), n.Data["comment_id"])
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), n.CreatedOn)
+	r.True(n.Unread())
+	r.False(notifications[1].Unread())
+
+	_, err = client.GetNotifications(context.Background(), &NotificationListOptions{Unread: true, Type: "comment", Limit: 20, Offset: 40})
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/notification/",
+		"/notification/?limit=20&offset=40&type=comment&viewed=false",
+	}, urls)
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.MarkNotificationRead(context.Background(), 9153939952))
+	r.NoError(client.MarkAllNotificationsRead(context.Background()))
+	r.Equal([]string{
+		"POST /notification/9153939952/viewed",
+		"POST /notification/viewed",
+	}, requests)
+}
+
+func TestNotificationCounts(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/notification/count", req.URL.Path)
+		w.Write([]byte(`{"new": 3, "total": 7}`))
+	})
+
+	count, err := client.GetNotificationCount(context.Background())
+	r.NoError(err)
+	r.Equal(&NotificationCount{New: 3, Total: 7}, count)
+
+	unseen, err := client.GetPersonalNotificationCount(context.Background())
+	r.NoError(err)
+	r.Equal(3, unseen)
+
+	unread, err := client.GetUnreadNotificationCount(context.Background())
+	r.NoError(err)
+	r.Equal(7, unread)
+}
@@ -1,21 +1,47 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
 
 type Organization struct {
-	Id   int64  `json:"org_id"`
-	Slug string `json:"url_label"`
-	Name string `json:"name"`
+	Id          int64  `json:"org_id"`
+	Slug        string `json:"url_label"`
+	Name        string `json:"name"`
+	Type        string `json:"type"` // free, sponsored or premium
+	Tier        string `json:"tier"`
+	MemberCount int    `json:"member_count"`
+	SpaceCount  int    `json:"space_count"`
 }
 
+// GetOrganizations returns the organizations of the authenticated user. If the client
+// was created with WithOrgListCache, the organizations are cached.
 func (client *Client) GetOrganizations() (orgs []Organization, err error) {
+	if client.orgListCache != nil {
+		if cached, ok := client.orgListCache.get(0); ok {
+			return append([]Organization(nil), cached.([]Organization)...), nil
+		}
+	}
+
 	err = client.Request("GET", "/org", nil, nil, &orgs)
+
+	if err == nil && client.orgListCache != nil {
+		client.orgListCache.set(0, append([]Organization(nil), orgs...))
+	}
 	return
 }
 
 func (client *Client) GetOrganization(id int64) (org *Organization, err error) {
+	return client.getOrganizationContext(context.Background(), id)
+}
+
+// getOrganizationContext is GetOrganization, but the request is aborted when ctx is cancelled
+func (client *Client) getOrganizationContext(ctx context.Context, id int64) (org *Organization, err error) {
 	path := fmt.Sprintf("/org/%d", id)
-	err = client.Request("GET", path, nil, nil, &org)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &org)
 	return
 }
 
@@ -24,3 +50,320 @@ func (client *Client) GetOrganizationBySlug(slug string) (org *Organization, err
 	err = client.Request("GET", path, nil, nil, &org)
 	return
 }
+
+// CreateOrgRequest describes a new organization
+type CreateOrgRequest struct {
+	Name string
+	Logo int64 // file id of the logo, if any
+}
+
+func (req *CreateOrgRequest) params() map[string]interface{} {
+	params := map[string]interface{}{
+		"name": req.Name,
+	}
+	if req.Logo != 0 {
+		params["logo"] = req.Logo
+	}
+	return params
+}
+
+// CreateOrganization creates an organization and returns the new organization. Podio
+// only responds with the id of the organization, so the organization is fetched with a
+// second request; if that request fails, the returned organization holds only the id.
+//
+// https://developers.podio.com/doc/organizations/add-new-organization-22385
+func (client *Client) CreateOrganization(ctx context.Context, req *CreateOrgRequest) (*Organization, error) {
+	rsp := &struct {
+		OrgId int64 `json:"org_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", "/org/", nil, req.params(), rsp)
+	if client.orgListCache != nil {
+		client.orgListCache.clear()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := client.getOrganizationContext(ctx, rsp.OrgId)
+	if err != nil {
+		return &Organization{Id: rsp.OrgId}, err
+	}
+	return org, nil
+}
+
+// UpdateOrgRequest holds the new name and logo of an organization. Only the settings
+// that are set, i.e. non-empty or non-zero, are changed.
+type UpdateOrgRequest struct {
+	Name string
+	Logo int64 // file id of the new logo
+}
+
+func (req *UpdateOrgRequest) params() map[string]interface{} {
+	params := map[string]interface{}{}
+	if req.Name != "" {
+		params["name"] = req.Name
+	}
+	if req.Logo != 0 {
+		params["logo"] = req.Logo
+	}
+	return params
+}
+
+// UpdateOrganization changes the name or logo of an organization and returns the updated
+// organization, which is fetched with a second request as Podio does not respond with it.
+//
+// https://developers.podio.com/doc/organizations/update-organization-22386
+func (client *Client) UpdateOrganization(ctx context.Context, orgId int64, req *UpdateOrgRequest) (*Organization, error) {
+	path := fmt.Sprintf("/org/%d", orgId)
+	err := client.requestWithParamsContext(ctx, "PUT", path, nil, req.params(), nil)
+	if client.orgListCache != nil {
+		client.orgListCache.clear()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getOrganizationContext(ctx, orgId)
+}
+
+// OrgMember is a member of an organization
+type OrgMember struct {
+	Profile          Profile    `json:"profile"`
+	Role             MemberRole `json:"role"`
+	Employee         bool       `json:"employee"`
+	SpaceMemberships int        `json:"space_memberships"` // number of spaces in the organization the user is a member of
+}
+
+// https://developers.podio.com/doc/organizations/get-organization-members-50661
+func (client *Client) GetOrgMembers(ctx context.Context, orgId int64) (members []*OrgMember, err error) {
+	path := fmt.Sprintf("/org/%d/member/", orgId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &members)
+	return
+}
+
+// InviteToOrganization invites people to an organization by mail. Mail addresses
+// of existing Podio users add the users directly, other addresses receive an
+// invitation to sign up.
+func (client *Client) InviteToOrganization(ctx context.Context, orgId int64, mails []string, role MemberRole) error {
+	path := fmt.Sprintf("/org/%d/member/", orgId)
+	params := map[string]interface{}{
+		"mails": mails,
+		"role":  role,
+	}
+
+	return client.requestWithParamsContext(ctx, "POST", path, nil, params, nil)
+}
+
+// RemoveOrgMember ends the membership of a user in an organization, and all of the
+// space memberships of the user in the organization.
+//
+// https://developers.podio.com/doc/organizations/end-organization-membership-50689
+func (client *Client) RemoveOrgMember(ctx context.Context, orgId, userId int64) error {
+	path := fmt.Sprintf("/org/%d/member/%d", orgId, userId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// OrganizationQuota describes how many items an organization may have, and how many it has.
+type OrganizationQuota struct {
+	ItemLimit int `json:"item_limit"` // 0 means there is no limit
+	ItemCount int `json:"item_count"`
+}
+
+// GetOrganizationQuota returns the item quota of an organization from GET /org/{org_id}/quota.
+// The quota is nil if Podio returns none.
+func (client *Client) GetOrganizationQuota(ctx context.Context, orgId int64) (quota *OrganizationQuota, err error) {
+	path := fmt.Sprintf("/org/%d/quota", orgId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &quota)
+	return
+}
+
+// GetQuotaRemaining returns the fraction of the item quota of an organization that is
+// still available, from 0.0 (quota used up) to 1.0 (no items, or no limit). An
+// organization without a quota has no limit.
+func (client *Client) GetQuotaRemaining(ctx context.Context, orgId int64) (float64, error) {
+	quota, err := client.GetOrganizationQuota(ctx, orgId)
+	if err != nil {
+		return 0, err
+	}
+
+	if quota == nil || quota.ItemLimit <= 0 {
+		return 1, nil
+	}
+
+	remaining := float64(quota.ItemLimit-quota.ItemCount) / float64(quota.ItemLimit)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, nil
+}
+
+// GetAppsGroupedBySpace returns the apps of all spaces in an organization, keyed by space id.
+// The apps of the spaces are fetched concurrently, a few spaces at a time.
+func (client *Client) GetAppsGroupedBySpace(ctx context.Context, orgId int64) (map[int64][]App, error) {
+	spaces, err := client.getSpacesContext(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getAppsOfSpaces(ctx, spaces)
+}
+
+// OrgHierarchy is an organization along with its spaces and their apps
+type OrgHierarchy struct {
+	Org    Organization
+	Spaces []SpaceHierarchy
+}
+
+// SpaceHierarchy is a space along with its apps
+type SpaceHierarchy struct {
+	Space Space
+	Apps  []App
+}
+
+// GetOrgHierarchy returns an organization with all of its spaces and apps.
+// The apps of the spaces are fetched concurrently, a few spaces at a time, and no
+// more are fetched once a request fails.
+func (client *Client) GetOrgHierarchy(ctx context.Context, orgId int64) (*OrgHierarchy, error) {
+	org, err := client.getOrganizationContext(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	spaces, err := client.getSpacesContext(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := client.getAppsOfSpaces(ctx, spaces)
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchy := &OrgHierarchy{
+		Org:    *org,
+		Spaces: make([]SpaceHierarchy, 0, len(spaces)),
+	}
+	for _, space := range spaces {
+		hierarchy.Spaces = append(hierarchy.Spaces, SpaceHierarchy{Space: space, Apps: apps[space.Id]})
+	}
+
+	return hierarchy, nil
+}
+
+// GetAllOrganizationItems returns the items of all apps in all spaces of an organization.
+// filter may be nil; as it is applied to every app, it should only filter and sort on item
+// properties such as created_on rather than on app specific fields. Limit and offset in
+// filter are ignored. Items are returned app by app in order of app id, and the
+// organization is walked until ctx is cancelled.
+func (client *Client) GetAllOrganizationItems(ctx context.Context, orgId int64, filter *ItemFilterRequest) ([]*Item, error) {
+	appsBySpace, err := client.GetAppsGroupedBySpace(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	appIds := []int64{}
+	for _, apps := range appsBySpace {
+		for _, app := range apps {
+			appIds = append(appIds, app.Id)
+		}
+	}
+	sort.Slice(appIds, func(i, j int) bool { return appIds[i] < appIds[j] })
+
+	var params map[string]interface{}
+	if filter != nil {
+		params = filter.Params()
+	}
+
+	seen := map[int64]bool{}
+	items := []*Item{}
+	for _, appId := range appIds {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		appItems, err := allItems(params, func(page map[string]interface{}) (*ItemList, error) {
+			return client.GetItemsWithView(ctx, appId, page, ItemViewFull)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range appItems {
+			if !seen[item.Id] {
+				seen[item.Id] = true
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// getAppsOfSpaces concurrently fetches the apps of the given spaces, keyed by space id.
+func (client *Client) getAppsOfSpaces(ctx context.Context, spaces []Space) (map[int64][]App, error) {
+	var (
+		mu   sync.Mutex
+		apps = make(map[int64][]App, len(spaces))
+	)
+
+	err := forEachConcurrently(len(spaces), func(i int) error {
+		spaceApps, err := client.getAppsContext(ctx, spaces[i].Id)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		apps[spaces[i].Id] = spaceApps
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// maxConcurrentRequests is the most requests the methods fetching from Podio
+// concurrently have in flight at a time, to stay clear of the rate limit
+const maxConcurrentRequests = 4
+
+// forEachConcurrently calls fn for 0 to n-1 from at most maxConcurrentRequests goroutines
+// at a time. No more calls are started after a call fails, and the first error is
+// returned once the running calls have finished.
+func forEachConcurrently(n int, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		slots    = make(chan struct{}, maxConcurrentRequests)
+	)
+
+	for i := 0; i < n; i++ {
+		slots <- struct{}{}
+
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			err := fn(i)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+			<-slots
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,396 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrgHierarchy(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/org/736":
+			w.Write([]byte(`{"org_id": 736, "name": "Podio"}`))
+		case "/org/736/space":
+			w.Write([]byte(`[{"space_id": 1, "name": "Sales"}, {"space_id": 2, "name": "Support"}]`))
+		case "/app/space/1":
+			w.Write([]byte(`[{"app_id": 10, "name": "Leads"}, {"app_id": 11, "name": "Deals"}]`))
+		case "/app/space/2":
+			w.Write([]byte(`[{"app_id": 20, "name": "Tickets"}]`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	hierarchy, err := client.GetOrgHierarchy(context.Background(), 736)
+	r.NoError(err)
+	r.Equal("Podio", hierarchy.Org.Name)
+	r.Len(hierarchy.Spaces, 2)
+	r.Equal("Sales", hierarchy.Spaces[0].Space.Name)
+	r.Len(hierarchy.Spaces[0].Apps, 2)
+	r.Equal("Support", hierarchy.Spaces[1].Space.Name)
+	r.Equal([]App{{Id: 20, Name: "Tickets"}}, hierarchy.Spaces[1].Apps)
+}
+
+func TestGetOrgHierarchyError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/org/736":
+			w.Write([]byte(`{"org_id": 736, "name": "Podio"}`))
+		case "/org/736/space":
+			w.Write([]byte(`[{"space_id": 1, "name": "Sales"}, {"space_id": 2, "name": "Support"}]`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+		}
+	})
+
+	hierarchy, err := client.GetOrgHierarchy(context.Background(), 736)
+	r.Nil(hierarchy)
+	r.EqualError(err, "forbidden: No access")
+}
+
+func TestGetAppsGroupedBySpaceError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/org/736/space":
+			w.Write([]byte(`[{"space_id": 1}, {"space_id": 2}]`))
+		case "/app/space/1":
+			w.Write([]byte(`[{"app_id": 10}]`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+		}
+	})
+
+	apps, err := client.GetAppsGroupedBySpace(context.Background(), 736)
+	r.Nil(apps)
+	r.EqualError(err, "forbidden: No access")
+}
+
+func TestGetAppsGroupedBySpaceConcurrency(t *testing.T) {
+	r := require.New(t)
+
+	spaces := []string{}
+	for i := 1; i <= 20; i++ {
+		spaces = append(spaces, fmt.Sprintf(`{"space_id": %d}`, i))
+	}
+
+	var (
+		mu               sync.Mutex
+		running, maxSeen int
+		calls            int
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/org/736/space" {
+			w.Write([]byte("[" + strings.Join(spaces, ",") + "]"))
+			return
+		}
+
+		mu.Lock()
+		running++
+		calls++
+		if running > maxSeen {
+			maxSeen = running
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`[]`))
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+	})
+
+	apps, err := client.GetAppsGroupedBySpace(context.Background(), 736)
+	r.NoError(err)
+	r.Len(apps, 20)
+	r.Equal(20, calls)
+	r.True(maxSeen <= maxConcurrentRequests, "%d requests in flight", maxSeen)
+}
+
+func TestGetAppsGroupedBySpaceStopsOnError(t *testing.T) {
+	r := require.New(t)
+
+	spaces := []string{}
+	for i := 1; i <= 20; i++ {
+		spaces = append(spaces, fmt.Sprintf(`{"space_id": %d}`, i))
+	}
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/org/736/space" {
+			w.Write([]byte("[" + strings.Join(spaces, ",") + "]"))
+			return
+		}
+
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		if req.URL.Path == "/app/space/1" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`[]`))
+	})
+
+	_, err := client.GetAppsGroupedBySpace(context.Background(), 736)
+	r.EqualError(err, "forbidden: No access")
+	r.True(calls < 20, "all %d spaces were requested", calls)
+}
+
+func TestGetAllOrganizationItems(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/org/736/space":
+			w.Write([]byte(`[{"space_id": 1}, {"space_id": 2}]`))
+		case "/app/space/1":
+			w.Write([]byte(`[{"app_id": 11}, {"app_id": 10}]`))
+		case "/app/space/2":
+			w.Write([]byte(`[{"app_id": 20}]`))
+		case "/item/app/10/filter", "/item/app/11/filter", "/item/app/20/filter":
+			params := map[string]interface{}{}
+			r.NoError(json.NewDecoder(req.Body).Decode(&params))
+			r.Equal("created_on", params["sort_by"])
+
+			switch req.URL.Path {
+			case "/item/app/10/filter":
+				w.Write([]byte(`{"filtered": 2, "items": [{"item_id": 100}, {"item_id": 101}]}`))
+			case "/item/app/11/filter":
+				w.Write([]byte(`{"filtered": 0, "items": []}`))
+			case "/item/app/20/filter":
+				// an item seen earlier, e.g. one moved between apps while walking the org
+				w.Write([]byte(`{"filtered": 2, "items": [{"item_id": 101}, {"item_id": 200}]}`))
+			}
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	filter, err := NewFilterBuilder().SortBy("created_on", false).Build()
+	r.NoError(err)
+
+	items, err := client.GetAllOrganizationItems(context.Background(), 736, filter)
+	r.NoError(err)
+
+	ids := []int64{}
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	r.Equal([]int64{100, 101, 200}, ids)
+}
+
+func TestGetAllOrganizationItemsCancelled(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/org/736/space":
+			w.Write([]byte(`[{"space_id": 1}]`))
+		case "/app/space/1":
+			w.Write([]byte(`[{"app_id": 10}]`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	items, err := client.GetAllOrganizationItems(ctx, 736, nil)
+	r.Nil(items)
+	r.True(errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+}
+
+func TestGetAllOrganizationItemsCancelledWhilePaging(t *testing.T) {
+	r := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pages := 0
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/org/736/space":
+			w.Write([]byte(`[{"space_id": 1}]`))
+		case "/app/space/1":
+			w.Write([]byte(`[{"app_id": 10}]`))
+		case "/item/app/10/filter":
+			pages++
+			cancel()
+			w.Write([]byte(`{"filtered": 3, "items": [{"item_id": 100}]}`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	items, err := client.GetAllOrganizationItems(ctx, 736, nil)
+	r.Nil(items)
+	r.True(errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+	r.Equal(1, pages)
+}
+
+func TestCreateOrganization(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "GET" {
+			w.Write([]byte(`{"org_id": 737, "name": "Podio Labs", "url_label": "podio-labs", "type": "free"}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"name": "Podio Labs"}`, string(body))
+		w.Write([]byte(`{"org_id": 737, "url": "https://podio.com/podio-labs"}`))
+	})
+
+	org, err := client.CreateOrganization(context.Background(), &CreateOrgRequest{Name: "Podio Labs"})
+	r.NoError(err)
+	r.Equal(&Organization{Id: 737, Name: "Podio Labs", Slug: "podio-labs", Type: "free"}, org)
+	r.Equal([]string{"POST /org/", "GET /org/737"}, requests)
+}
+
+func TestUpdateOrganization(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		r.Equal("/org/736", req.URL.Path)
+		if req.Method == "GET" {
+			w.Write([]byte(`{"org_id": 736, "name": "Podio"}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"logo": 59571302}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	org, err := client.UpdateOrganization(context.Background(), 736, &UpdateOrgRequest{Logo: 59571302})
+	r.NoError(err)
+	r.Equal(&Organization{Id: 736, Name: "Podio"}, org)
+	r.Equal([]string{"PUT /org/736", "GET /org/736"}, requests)
+}
+
+func TestGetOrgMembers(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/org/736/member/", req.URL.Path)
+		w.Write([]byte(`[
+			{"profile": {"user_id": 2468975, "profile_id": 140798621, "name": "Brian"}, "role": "admin", "employee": true, "space_memberships": 4},
+			{"profile": {"user_id": 1540133, "profile_id": 32952940, "name": "Anne"}, "role": "light", "employee": false, "space_memberships": 1}
+		]`))
+	})
+
+	members, err := client.GetOrgMembers(context.Background(), 736)
+	r.NoError(err)
+	r.Equal([]*OrgMember{
+		{Profile: Profile{UserId: 2468975, ProfileId: 140798621, Name: "Brian"}, Role: MemberRoleAdmin, Employee: true, SpaceMemberships: 4},
+		{Profile: Profile{UserId: 1540133, ProfileId: 32952940, Name: "Anne"}, Role: MemberRoleLight, SpaceMemberships: 1},
+	}, members)
+}
+
+func TestGetOrganizationQuota(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/org/736/quota", req.URL.Path)
+		w.Write([]byte(`{"item_limit": 5000, "item_count": 1250}`))
+	})
+
+	quota, err := client.GetOrganizationQuota(context.Background(), 736)
+	r.NoError(err)
+	r.Equal(&OrganizationQuota{ItemLimit: 5000, ItemCount: 1250}, quota)
+
+	remaining, err := client.GetQuotaRemaining(context.Background(), 736)
+	r.NoError(err)
+	r.Equal(0.75, remaining)
+}
+
+func TestGetQuotaRemaining(t *testing.T) {
+	r := require.New(t)
+
+	for body, expected := range map[string]float64{
+		`{"item_limit": 0, "item_count": 1250}`:    1,
+		`{"item_limit": 1000, "item_count": 1250}`: 0,
+		`{"item_limit": 1000, "item_count": 1000}`: 0,
+		`null`: 1,
+	} {
+		client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+			w.Write([]byte(body))
+		})
+
+		remaining, err := client.GetQuotaRemaining(context.Background(), 736)
+		r.NoError(err, body)
+		r.Equal(expected, remaining, body)
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "No access"}`))
+	})
+	_, err := client.GetQuotaRemaining(context.Background(), 736)
+	r.EqualError(err, "forbidden: No access")
+}
+
+func TestInviteToOrganization(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/org/736/member/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"mails": ["brian@podio.com", "not-a-user@example.com"], "role": "regular"}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.InviteToOrganization(context.Background(), 736, []string{"brian@podio.com", "not-a-user@example.com"}, MemberRoleRegular))
+}
+
+func TestRemoveOrgMember(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/org/736/member/2468975", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.RemoveOrgMember(context.Background(), 736, 2468975))
+}
@@ -0,0 +1,179 @@
+package podiotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// CassetteMode selects whether a CassetteTransport records or replays
+type CassetteMode int
+
+const (
+	// Record sends requests to the API and saves them with their responses
+	Record CassetteMode = iota
+	// Replay serves the saved responses without making any requests
+	Replay
+)
+
+// Interaction is a request and its response, as saved in a cassette
+type Interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body"`
+	} `json:"request"`
+	Response struct {
+		Status  int         `json:"status"`
+		Headers http.Header `json:"headers"`
+		Body    string      `json:"body"`
+	} `json:"response"`
+}
+
+// cassette is the content of a cassette file
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// CassetteTransport is an http.RoundTripper that records API requests to a cassette
+// file, or replays them from it, for integration tests that run without network access.
+// Use it with podio.WithRoundTripper.
+//
+// Request headers are not saved, so the access token is not written to the cassette.
+// In replay mode, requests are matched by method and URL path and query, in the
+// order they were recorded.
+type CassetteTransport struct {
+	// Transport sends the requests in record mode. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	path string
+	mode CassetteMode
+
+	mu       sync.Mutex
+	cassette cassette
+	used     []bool // interactions already replayed
+}
+
+// NewCassette returns a transport recording to or replaying from the cassette file at
+// path. In replay mode the file is read here and must exist; in record mode it is
+// replaced as requests are made.
+func NewCassette(path string, mode CassetteMode) (*CassetteTransport, error) {
+	transport := &CassetteTransport{path: path, mode: mode}
+	if mode != Replay {
+		return transport, nil
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, &transport.cassette); err != nil {
+		return nil, fmt.Errorf("podiotest: cassette %s: %v", path, err)
+	}
+	transport.used = make([]bool, len(transport.cassette.Interactions))
+	return transport, nil
+}
+
+// RoundTrip records or replays a request
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == Replay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+func (t *CassetteTransport) record(req *http.Request) (*http.Response, error) {
+	interaction := &Interaction{}
+	interaction.Request.Method = req.Method
+	interaction.Request.URL = req.URL.String()
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		interaction.Request.Body = string(body)
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	interaction.Response.Status = resp.StatusCode
+	interaction.Response.Headers = resp.Header
+	interaction.Response.Body = string(body)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// save writes the cassette file, keeping it complete after every request
+func (t *CassetteTransport) save() error {
+	buf, err := json.MarshalIndent(&t.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(t.path, buf, 0644)
+}
+
+func (t *CassetteTransport) replay(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.cassette.Interactions {
+		if t.used[i] || interaction.Request.Method != req.Method || !sameRequestURI(interaction.Request.URL, req) {
+			continue
+		}
+		t.used[i] = true
+
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, errors.New("podiotest: no recorded response for " + req.Method + " " + req.URL.RequestURI())
+}
+
+// sameRequestURI reports whether the recorded URL has the path and query of req
+func sameRequestURI(recorded string, req *http.Request) bool {
+	recordedReq, err := http.NewRequest("GET", recorded, nil)
+	if err != nil {
+		return false
+	}
+	return recordedReq.URL.RequestURI() == req.URL.RequestURI()
+}
@@ -0,0 +1,83 @@
+package podiotest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	requests := 0
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		switch req.URL.Path {
+		case "/item/582709679":
+			w.Write([]byte(`{"item_id": 582709679, "title": "Offer"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "not_found", "error_description": "Object not found"}`))
+		}
+	}))
+
+	recorder, err := NewCassette(path, Record)
+	r.NoError(err)
+	client := podio.NewClient(&podio.AuthToken{AccessToken: "secret"}, podio.WithRoundTripper(recorder), podio.WithBaseURL(api.URL))
+
+	recorded, err := client.GetItem(582709679)
+	r.NoError(err)
+	_, err = client.GetItem(1)
+	r.Error(err)
+	r.Equal(2, requests)
+
+	// no network access when replaying
+	api.Close()
+
+	player, err := NewCassette(path, Replay)
+	r.NoError(err)
+	client = podio.NewClient(&podio.AuthToken{AccessToken: "secret"}, podio.WithRoundTripper(player), podio.WithBaseURL(api.URL))
+
+	replayed, err := client.GetItem(582709679)
+	r.NoError(err)
+	r.Equal(recorded, replayed)
+
+	_, err = client.GetItem(1)
+	nf := &podio.NotFoundError{}
+	r.ErrorAs(err, &nf)
+
+	// each recorded response is replayed once
+	_, err = client.GetItem(582709679)
+	r.Error(err)
+	r.Contains(err.Error(), "podiotest: no recorded response for GET /item/582709679?fields=files")
+}
+
+func TestCassetteDoesNotSaveToken(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer(t)
+	server.AddItem(&podio.Item{Id: 1})
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewCassette(path, Record)
+	r.NoError(err)
+
+	client := podio.NewClient(&podio.AuthToken{AccessToken: "secret"}, podio.WithRoundTripper(recorder), podio.WithBaseURL(server.URL()))
+	_, err = client.GetItem(1)
+	r.NoError(err)
+
+	buf, err := ioutil.ReadFile(path)
+	r.NoError(err)
+	r.Contains(string(buf), `"url": "`+server.URL()+`/item/1?fields=files"`)
+	r.NotContains(string(buf), "secret")
+
+	_, err = NewCassette(filepath.Join(t.TempDir(), "missing.json"), Replay)
+	r.Error(err)
+}
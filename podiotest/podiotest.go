@@ -0,0 +1,77 @@
+// Package podiotest provides test doubles for code using the podio client: a
+// RecordingClient standing in for a *podio.Client, and a Server faking the Podio API.
+package podiotest
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Call is a method call made on a RecordingClient, along with the values it returned
+type Call struct {
+	Method  string
+	Args    []interface{}
+	Returns []interface{}
+}
+
+// RecordingClient implements podio.ClientInterface without making any requests. It
+// records the calls made on it and returns the values set with SetReturn, or zero
+// values for methods without. The zero value is ready to use, and a RecordingClient
+// is safe for concurrent use.
+type RecordingClient struct {
+	mu      sync.Mutex
+	calls   []Call
+	returns map[string][]interface{}
+}
+
+// SetReturn sets the values returned by every call of method, in the order of the
+// results of the method, e.g.
+//
+//	client.SetReturn("GetItem", &podio.Item{Id: 1}, nil)
+//
+// Missing and nil values are returned as zero values. A call of method panics if a
+// value does not have the type of the result it is returned as.
+func (c *RecordingClient) SetReturn(method string, values ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.returns == nil {
+		c.returns = map[string][]interface{}{}
+	}
+	c.returns[method] = values
+}
+
+// Calls returns the calls made on the client so far, in the order they were made
+func (c *RecordingClient) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// Reset forgets the calls made on the client. Return values set with SetReturn are kept.
+func (c *RecordingClient) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = nil
+}
+
+// record adds a call of method and returns the n values it should return
+func (c *RecordingClient) record(method string, args []interface{}, n int) []interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ret := make([]interface{}, n)
+	copy(ret, c.returns[method])
+	c.calls = append(c.calls, Call{Method: method, Args: args, Returns: ret})
+	return ret
+}
+
+// checkReturn panics if the i'th value set with SetReturn for method, which ok reports
+// whether it could be converted to, does not have the result type typ
+func checkReturn(method string, i int, value interface{}, ok bool, typ string) {
+	if !ok && value != nil {
+		panic(fmt.Sprintf("podiotest: value %d set with SetReturn for %s is %T, but the result is %s", i, method, value, typ))
+	}
+}
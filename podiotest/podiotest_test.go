@@ -0,0 +1,70 @@
+package podiotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+// itemTitle stands in for user code depending on the client interface
+func itemTitle(client podio.ClientInterface, itemId int64) (string, error) {
+	item, err := client.GetItem(itemId)
+	if err != nil {
+		return "", err
+	}
+	return item.Title, nil
+}
+
+func TestRecordingClient(t *testing.T) {
+	r := require.New(t)
+
+	client := &RecordingClient{}
+	client.SetReturn("GetItem", &podio.Item{Id: 1, Title: "Offer"}, nil)
+
+	title, err := itemTitle(client, 1)
+	r.NoError(err)
+	r.Equal("Offer", title)
+
+	r.NoError(client.CompleteTask(context.Background(), 71844916))
+
+	r.Equal([]Call{
+		{Method: "GetItem", Args: []interface{}{int64(1)}, Returns: []interface{}{&podio.Item{Id: 1, Title: "Offer"}, nil}},
+		{Method: "CompleteTask", Args: []interface{}{context.Background(), int64(71844916)}, Returns: []interface{}{nil}},
+	}, client.Calls())
+
+	client.Reset()
+	r.Empty(client.Calls())
+}
+
+func TestRecordingClientZeroValues(t *testing.T) {
+	r := require.New(t)
+
+	client := &RecordingClient{}
+	apps, err := client.GetApps(2720177)
+	r.NoError(err)
+	r.Nil(apps)
+
+	client.SetReturn("GetApps", nil, errors.New("boom"))
+	_, err = client.GetApps(2720177)
+	r.EqualError(err, "boom")
+	r.Len(client.Calls(), 2)
+}
+
+func TestRecordingClientWrongReturnType(t *testing.T) {
+	r := require.New(t)
+
+	client := &RecordingClient{}
+	client.SetReturn("GetItem", podio.Item{Id: 1}, nil)
+	r.PanicsWithValue("podiotest: value 0 set with SetReturn for GetItem is podio.Item, but the result is *podio.Item", func() {
+		client.GetItem(1)
+	})
+
+	// nil values, typed or not, are returned as zero values
+	client.SetReturn("GetItem", (*podio.Item)(nil), nil)
+	item, err := client.GetItem(1)
+	r.NoError(err)
+	r.Nil(item)
+}
@@ -0,0 +1,1554 @@
+// Code generated by gen_client.go; DO NOT EDIT.
+
+package podiotest
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/andreas/podio-go"
+)
+
+var _ podio.ClientInterface = (*RecordingClient)(nil)
+
+func (c *RecordingClient) GetFieldOptions(ctx context.Context, appId int64, externalId string) ([]podio.CategoryOption, error) {
+	ret := c.record("GetFieldOptions", []interface{}{ctx, appId, externalId}, 2)
+	r0, ok := ret[0].([]podio.CategoryOption)
+	checkReturn("GetFieldOptions", 0, ret[0], ok, "[]podio.CategoryOption")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFieldOptions", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) SetFieldRequired(ctx context.Context, appId int64, externalId string, required bool) error {
+	ret := c.record("SetFieldRequired", []interface{}{ctx, appId, externalId, required}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("SetFieldRequired", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) SetFieldOptional(ctx context.Context, appId int64, externalId string) error {
+	ret := c.record("SetFieldOptional", []interface{}{ctx, appId, externalId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("SetFieldOptional", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) AddCategoryOption(ctx context.Context, appId int64, externalId string, text string, color string) (*podio.CategoryOption, error) {
+	ret := c.record("AddCategoryOption", []interface{}{ctx, appId, externalId, text, color}, 2)
+	r0, ok := ret[0].(*podio.CategoryOption)
+	checkReturn("AddCategoryOption", 0, ret[0], ok, "*podio.CategoryOption")
+	r1, ok := ret[1].(error)
+	checkReturn("AddCategoryOption", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) RemoveCategoryOption(ctx context.Context, appId int64, externalId string, optionId int) error {
+	ret := c.record("RemoveCategoryOption", []interface{}{ctx, appId, externalId, optionId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("RemoveCategoryOption", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetAppWithFields(ctx context.Context, appId int64) (*podio.AppFull, error) {
+	ret := c.record("GetAppWithFields", []interface{}{ctx, appId}, 2)
+	r0, ok := ret[0].(*podio.AppFull)
+	checkReturn("GetAppWithFields", 0, ret[0], ok, "*podio.AppFull")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppWithFields", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetApps(spaceId int64) ([]podio.App, error) {
+	ret := c.record("GetApps", []interface{}{spaceId}, 2)
+	r0, ok := ret[0].([]podio.App)
+	checkReturn("GetApps", 0, ret[0], ok, "[]podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("GetApps", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetApp(id int64) (*podio.App, error) {
+	ret := c.record("GetApp", []interface{}{id}, 2)
+	r0, ok := ret[0].(*podio.App)
+	checkReturn("GetApp", 0, ret[0], ok, "*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("GetApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppView(ctx context.Context, id int64, view string) (*podio.App, error) {
+	ret := c.record("GetAppView", []interface{}{ctx, id, view}, 2)
+	r0, ok := ret[0].(*podio.App)
+	checkReturn("GetAppView", 0, ret[0], ok, "*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppView", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAuthenticatedApp(ctx context.Context) (*podio.App, error) {
+	ret := c.record("GetAuthenticatedApp", []interface{}{ctx}, 2)
+	r0, ok := ret[0].(*podio.App)
+	checkReturn("GetAuthenticatedApp", 0, ret[0], ok, "*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAuthenticatedApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppBySpaceIdAndSlug(spaceId int64, slug string) (*podio.App, error) {
+	ret := c.record("GetAppBySpaceIdAndSlug", []interface{}{spaceId, slug}, 2)
+	r0, ok := ret[0].(*podio.App)
+	checkReturn("GetAppBySpaceIdAndSlug", 0, ret[0], ok, "*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppBySpaceIdAndSlug", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppFields(ctx context.Context, appId int64) ([]*podio.AppField, error) {
+	ret := c.record("GetAppFields", []interface{}{ctx, appId}, 2)
+	r0, ok := ret[0].([]*podio.AppField)
+	checkReturn("GetAppFields", 0, ret[0], ok, "[]*podio.AppField")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppFields", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppField(ctx context.Context, appId int64, fieldId int64) (*podio.AppField, error) {
+	ret := c.record("GetAppField", []interface{}{ctx, appId, fieldId}, 2)
+	r0, ok := ret[0].(*podio.AppField)
+	checkReturn("GetAppField", 0, ret[0], ok, "*podio.AppField")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppField", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateAppField(ctx context.Context, appId int64, req *podio.AppFieldRequest) (int64, error) {
+	ret := c.record("CreateAppField", []interface{}{ctx, appId, req}, 2)
+	r0, ok := ret[0].(int64)
+	checkReturn("CreateAppField", 0, ret[0], ok, "int64")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateAppField", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateAppField(ctx context.Context, appId int64, fieldId int64, req *podio.AppFieldRequest) error {
+	ret := c.record("UpdateAppField", []interface{}{ctx, appId, fieldId, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateAppField", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteAppField(ctx context.Context, appId int64, fieldId int64) error {
+	ret := c.record("DeleteAppField", []interface{}{ctx, appId, fieldId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteAppField", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) CreateApp(ctx context.Context, spaceId int64, req *podio.CreateAppRequest) (*podio.App, error) {
+	ret := c.record("CreateApp", []interface{}{ctx, spaceId, req}, 2)
+	r0, ok := ret[0].(*podio.App)
+	checkReturn("CreateApp", 0, ret[0], ok, "*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateApp(ctx context.Context, appId int64, req *podio.UpdateAppRequest) error {
+	ret := c.record("UpdateApp", []interface{}{ctx, appId, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateApp", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteApp(ctx context.Context, appId int64) error {
+	ret := c.record("DeleteApp", []interface{}{ctx, appId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteApp", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) CloneApp(ctx context.Context, appId int64, targetSpaceId int64) (*podio.App, error) {
+	ret := c.record("CloneApp", []interface{}{ctx, appId, targetSpaceId}, 2)
+	r0, ok := ret[0].(*podio.App)
+	checkReturn("CloneApp", 0, ret[0], ok, "*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("CloneApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppRevisions(ctx context.Context, appId int64) ([]*podio.AppRevision, error) {
+	ret := c.record("GetAppRevisions", []interface{}{ctx, appId}, 2)
+	r0, ok := ret[0].([]*podio.AppRevision)
+	checkReturn("GetAppRevisions", 0, ret[0], ok, "[]*podio.AppRevision")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppRevisions", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppRevision(ctx context.Context, appId int64, revision int) (*podio.AppRevision, error) {
+	ret := c.record("GetAppRevision", []interface{}{ctx, appId, revision}, 2)
+	r0, ok := ret[0].(*podio.AppRevision)
+	checkReturn("GetAppRevision", 0, ret[0], ok, "*podio.AppRevision")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppRevision", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) RevertApp(ctx context.Context, appId int64, revision int) error {
+	ret := c.record("RevertApp", []interface{}{ctx, appId, revision}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("RevertApp", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) ActivateApp(ctx context.Context, appId int64) error {
+	ret := c.record("ActivateApp", []interface{}{ctx, appId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("ActivateApp", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeactivateApp(ctx context.Context, appId int64) error {
+	ret := c.record("DeactivateApp", []interface{}{ctx, appId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeactivateApp", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetGlobalCalendar(ctx context.Context, from time.Time, to time.Time) ([]*podio.CalendarEvent, error) {
+	ret := c.record("GetGlobalCalendar", []interface{}{ctx, from, to}, 2)
+	r0, ok := ret[0].([]*podio.CalendarEvent)
+	checkReturn("GetGlobalCalendar", 0, ret[0], ok, "[]*podio.CalendarEvent")
+	r1, ok := ret[1].(error)
+	checkReturn("GetGlobalCalendar", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppCalendar(ctx context.Context, appId int64, from time.Time, to time.Time) ([]*podio.CalendarEvent, error) {
+	ret := c.record("GetAppCalendar", []interface{}{ctx, appId, from, to}, 2)
+	r0, ok := ret[0].([]*podio.CalendarEvent)
+	checkReturn("GetAppCalendar", 0, ret[0], ok, "[]*podio.CalendarEvent")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppCalendar", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetSpaceCalendar(ctx context.Context, spaceId int64, from time.Time, to time.Time) ([]*podio.CalendarEvent, error) {
+	ret := c.record("GetSpaceCalendar", []interface{}{ctx, spaceId, from, to}, 2)
+	r0, ok := ret[0].([]*podio.CalendarEvent)
+	checkReturn("GetSpaceCalendar", 0, ret[0], ok, "[]*podio.CalendarEvent")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaceCalendar", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) Request(method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
+	ret := c.record("Request", []interface{}{method, path, headers, body, out}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("Request", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) RequestWithParams(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error {
+	ret := c.record("RequestWithParams", []interface{}{method, path, headers, params, out}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("RequestWithParams", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) Comment(refType string, refId int64, text string, params map[string]interface{}) (*podio.Comment, error) {
+	ret := c.record("Comment", []interface{}{refType, refId, text, params}, 2)
+	r0, ok := ret[0].(*podio.Comment)
+	checkReturn("Comment", 0, ret[0], ok, "*podio.Comment")
+	r1, ok := ret[1].(error)
+	checkReturn("Comment", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateCommentWithFiles(ctx context.Context, refType string, refId int64, text string, fileIds []int64) (*podio.Comment, error) {
+	ret := c.record("CreateCommentWithFiles", []interface{}{ctx, refType, refId, text, fileIds}, 2)
+	r0, ok := ret[0].(*podio.Comment)
+	checkReturn("CreateCommentWithFiles", 0, ret[0], ok, "*podio.Comment")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateCommentWithFiles", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetComments(refType string, refId int64) ([]*podio.Comment, error) {
+	ret := c.record("GetComments", []interface{}{refType, refId}, 2)
+	r0, ok := ret[0].([]*podio.Comment)
+	checkReturn("GetComments", 0, ret[0], ok, "[]*podio.Comment")
+	r1, ok := ret[1].(error)
+	checkReturn("GetComments", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateComment(ctx context.Context, commentId int64, text string) error {
+	ret := c.record("UpdateComment", []interface{}{ctx, commentId, text}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateComment", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteComment(ctx context.Context, commentId int64) error {
+	ret := c.record("DeleteComment", []interface{}{ctx, commentId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteComment", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetComment(ctx context.Context, commentId int64) (*podio.Comment, error) {
+	ret := c.record("GetComment", []interface{}{ctx, commentId}, 2)
+	r0, ok := ret[0].(*podio.Comment)
+	checkReturn("GetComment", 0, ret[0], ok, "*podio.Comment")
+	r1, ok := ret[1].(error)
+	checkReturn("GetComment", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) LikeComment(ctx context.Context, commentId int64) error {
+	ret := c.record("LikeComment", []interface{}{ctx, commentId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("LikeComment", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) UnlikeComment(ctx context.Context, commentId int64) error {
+	ret := c.record("UnlikeComment", []interface{}{ctx, commentId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UnlikeComment", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetCommentLikeCount(ctx context.Context, commentId int64) (int, error) {
+	ret := c.record("GetCommentLikeCount", []interface{}{ctx, commentId}, 2)
+	r0, ok := ret[0].(int)
+	checkReturn("GetCommentLikeCount", 0, ret[0], ok, "int")
+	r1, ok := ret[1].(error)
+	checkReturn("GetCommentLikeCount", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemCommenters(ctx context.Context, itemId int64) ([]*podio.Profile, error) {
+	ret := c.record("GetItemCommenters", []interface{}{ctx, itemId}, 2)
+	r0, ok := ret[0].([]*podio.Profile)
+	checkReturn("GetItemCommenters", 0, ret[0], ok, "[]*podio.Profile")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemCommenters", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetPersonalConnections(ctx context.Context) ([]*podio.Profile, error) {
+	ret := c.record("GetPersonalConnections", []interface{}{ctx}, 2)
+	r0, ok := ret[0].([]*podio.Profile)
+	checkReturn("GetPersonalConnections", 0, ret[0], ok, "[]*podio.Profile")
+	r1, ok := ret[1].(error)
+	checkReturn("GetPersonalConnections", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrgContacts(ctx context.Context, orgId int64, opts *podio.ContactListOptions) ([]*podio.Contact, error) {
+	ret := c.record("GetOrgContacts", []interface{}{ctx, orgId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Contact)
+	checkReturn("GetOrgContacts", 0, ret[0], ok, "[]*podio.Contact")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrgContacts", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetSpaceContacts(ctx context.Context, spaceId int64, opts *podio.ContactListOptions) ([]*podio.Contact, error) {
+	ret := c.record("GetSpaceContacts", []interface{}{ctx, spaceId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Contact)
+	checkReturn("GetSpaceContacts", 0, ret[0], ok, "[]*podio.Contact")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaceContacts", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetConversations(ctx context.Context, opts *podio.ConversationListOptions) ([]*podio.Conversation, error) {
+	ret := c.record("GetConversations", []interface{}{ctx, opts}, 2)
+	r0, ok := ret[0].([]*podio.Conversation)
+	checkReturn("GetConversations", 0, ret[0], ok, "[]*podio.Conversation")
+	r1, ok := ret[1].(error)
+	checkReturn("GetConversations", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetConversation(ctx context.Context, conversationId int64) (*podio.Conversation, error) {
+	ret := c.record("GetConversation", []interface{}{ctx, conversationId}, 2)
+	r0, ok := ret[0].(*podio.Conversation)
+	checkReturn("GetConversation", 0, ret[0], ok, "*podio.Conversation")
+	r1, ok := ret[1].(error)
+	checkReturn("GetConversation", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateConversation(ctx context.Context, req *podio.CreateConversationRequest) (*podio.Conversation, error) {
+	ret := c.record("CreateConversation", []interface{}{ctx, req}, 2)
+	r0, ok := ret[0].(*podio.Conversation)
+	checkReturn("CreateConversation", 0, ret[0], ok, "*podio.Conversation")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateConversation", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) ReplyToConversation(ctx context.Context, conversationId int64, text string, fileIds []int64) error {
+	ret := c.record("ReplyToConversation", []interface{}{ctx, conversationId, text, fileIds}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("ReplyToConversation", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetConversationMessages(ctx context.Context, conversationId int64, opts *podio.MessageListOptions) ([]*podio.ConversationMessage, error) {
+	ret := c.record("GetConversationMessages", []interface{}{ctx, conversationId, opts}, 2)
+	r0, ok := ret[0].([]*podio.ConversationMessage)
+	checkReturn("GetConversationMessages", 0, ret[0], ok, "[]*podio.ConversationMessage")
+	r1, ok := ret[1].(error)
+	checkReturn("GetConversationMessages", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) AddConversationParticipant(ctx context.Context, conversationId int64, profileId int64) error {
+	ret := c.record("AddConversationParticipant", []interface{}{ctx, conversationId, profileId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("AddConversationParticipant", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) CreateEmbed(ctx context.Context, url string) (*podio.Embed, error) {
+	ret := c.record("CreateEmbed", []interface{}{ctx, url}, 2)
+	r0, ok := ret[0].(*podio.Embed)
+	checkReturn("CreateEmbed", 0, ret[0], ok, "*podio.Embed")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateEmbed", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetEmbed(ctx context.Context, embedId int64) (*podio.Embed, error) {
+	ret := c.record("GetEmbed", []interface{}{ctx, embedId}, 2)
+	r0, ok := ret[0].(*podio.Embed)
+	checkReturn("GetEmbed", 0, ret[0], ok, "*podio.Embed")
+	r1, ok := ret[1].(error)
+	checkReturn("GetEmbed", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFiles() ([]podio.File, error) {
+	ret := c.record("GetFiles", []interface{}{}, 2)
+	r0, ok := ret[0].([]podio.File)
+	checkReturn("GetFiles", 0, ret[0], ok, "[]podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFiles", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFilesWithOptions(ctx context.Context, opts *podio.FileListOptions) ([]*podio.File, error) {
+	ret := c.record("GetFilesWithOptions", []interface{}{ctx, opts}, 2)
+	r0, ok := ret[0].([]*podio.File)
+	checkReturn("GetFilesWithOptions", 0, ret[0], ok, "[]*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFilesWithOptions", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFilesByItem(ctx context.Context, itemId int64, opts *podio.FileListOptions) ([]*podio.File, error) {
+	ret := c.record("GetFilesByItem", []interface{}{ctx, itemId, opts}, 2)
+	r0, ok := ret[0].([]*podio.File)
+	checkReturn("GetFilesByItem", 0, ret[0], ok, "[]*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFilesByItem", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFilesByApp(ctx context.Context, appId int64, opts *podio.FileListOptions) ([]*podio.File, error) {
+	ret := c.record("GetFilesByApp", []interface{}{ctx, appId, opts}, 2)
+	r0, ok := ret[0].([]*podio.File)
+	checkReturn("GetFilesByApp", 0, ret[0], ok, "[]*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFilesByApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFilesBySpace(ctx context.Context, spaceId int64, opts *podio.FileListOptions) ([]*podio.File, error) {
+	ret := c.record("GetFilesBySpace", []interface{}{ctx, spaceId, opts}, 2)
+	r0, ok := ret[0].([]*podio.File)
+	checkReturn("GetFilesBySpace", 0, ret[0], ok, "[]*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFilesBySpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFile(fileId int) (*podio.File, error) {
+	ret := c.record("GetFile", []interface{}{fileId}, 2)
+	r0, ok := ret[0].(*podio.File)
+	checkReturn("GetFile", 0, ret[0], ok, "*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFile", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetFileContents(url string) ([]byte, error) {
+	ret := c.record("GetFileContents", []interface{}{url}, 2)
+	r0, ok := ret[0].([]byte)
+	checkReturn("GetFileContents", 0, ret[0], ok, "[]byte")
+	r1, ok := ret[1].(error)
+	checkReturn("GetFileContents", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) DownloadFileAsReader(ctx context.Context, url string) (io.ReadCloser, error) {
+	ret := c.record("DownloadFileAsReader", []interface{}{ctx, url}, 2)
+	r0, ok := ret[0].(io.ReadCloser)
+	checkReturn("DownloadFileAsReader", 0, ret[0], ok, "io.ReadCloser")
+	r1, ok := ret[1].(error)
+	checkReturn("DownloadFileAsReader", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateFile(name string, contents []byte) (*podio.File, error) {
+	ret := c.record("CreateFile", []interface{}{name, contents}, 2)
+	r0, ok := ret[0].(*podio.File)
+	checkReturn("CreateFile", 0, ret[0], ok, "*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateFile", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateFileFromReader(ctx context.Context, name string, size int64, r io.Reader) (*podio.File, error) {
+	ret := c.record("CreateFileFromReader", []interface{}{ctx, name, size, r}, 2)
+	r0, ok := ret[0].(*podio.File)
+	checkReturn("CreateFileFromReader", 0, ret[0], ok, "*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateFileFromReader", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) ReplaceFile(oldFileId int, newFileId int) error {
+	ret := c.record("ReplaceFile", []interface{}{oldFileId, newFileId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("ReplaceFile", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) AttachFile(fileId int, refType string, refId int) error {
+	ret := c.record("AttachFile", []interface{}{fileId, refType, refId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("AttachFile", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteFile(fileId int) error {
+	ret := c.record("DeleteFile", []interface{}{fileId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteFile", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetForms(ctx context.Context, appId int64) ([]*podio.Form, error) {
+	ret := c.record("GetForms", []interface{}{ctx, appId}, 2)
+	r0, ok := ret[0].([]*podio.Form)
+	checkReturn("GetForms", 0, ret[0], ok, "[]*podio.Form")
+	r1, ok := ret[1].(error)
+	checkReturn("GetForms", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetForm(ctx context.Context, formId int64) (*podio.Form, error) {
+	ret := c.record("GetForm", []interface{}{ctx, formId}, 2)
+	r0, ok := ret[0].(*podio.Form)
+	checkReturn("GetForm", 0, ret[0], ok, "*podio.Form")
+	r1, ok := ret[1].(error)
+	checkReturn("GetForm", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) ActivateForm(ctx context.Context, formId int64) error {
+	ret := c.record("ActivateForm", []interface{}{ctx, formId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("ActivateForm", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeactivateForm(ctx context.Context, formId int64) error {
+	ret := c.record("DeactivateForm", []interface{}{ctx, formId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeactivateForm", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetGrants(ctx context.Context, refType string, refId int64) ([]*podio.Grant, error) {
+	ret := c.record("GetGrants", []interface{}{ctx, refType, refId}, 2)
+	r0, ok := ret[0].([]*podio.Grant)
+	checkReturn("GetGrants", 0, ret[0], ok, "[]*podio.Grant")
+	r1, ok := ret[1].(error)
+	checkReturn("GetGrants", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateGrant(ctx context.Context, refType string, refId int64, req *podio.GrantRequest) error {
+	ret := c.record("CreateGrant", []interface{}{ctx, refType, refId, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("CreateGrant", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) RevokeGrant(ctx context.Context, refType string, refId int64, userId int64) error {
+	ret := c.record("RevokeGrant", []interface{}{ctx, refType, refId, userId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("RevokeGrant", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetHooks(ctx context.Context, refType string, refId int64) ([]*podio.Hook, error) {
+	ret := c.record("GetHooks", []interface{}{ctx, refType, refId}, 2)
+	r0, ok := ret[0].([]*podio.Hook)
+	checkReturn("GetHooks", 0, ret[0], ok, "[]*podio.Hook")
+	r1, ok := ret[1].(error)
+	checkReturn("GetHooks", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateHook(ctx context.Context, refType string, refId int64, req *podio.CreateHookRequest) (*podio.Hook, error) {
+	ret := c.record("CreateHook", []interface{}{ctx, refType, refId, req}, 2)
+	r0, ok := ret[0].(*podio.Hook)
+	checkReturn("CreateHook", 0, ret[0], ok, "*podio.Hook")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateHook", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) DeleteHook(ctx context.Context, hookId int64) error {
+	ret := c.record("DeleteHook", []interface{}{ctx, hookId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteHook", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) VerifyHook(ctx context.Context, hookId int64, code string) error {
+	ret := c.record("VerifyHook", []interface{}{ctx, hookId, code}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("VerifyHook", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetItems(appId int64) (*podio.ItemList, error) {
+	ret := c.record("GetItems", []interface{}{appId}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("GetItems", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItems", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) FilterItems(appId int64, params map[string]interface{}) (*podio.ItemList, error) {
+	ret := c.record("FilterItems", []interface{}{appId, params}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("FilterItems", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("FilterItems", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemsSorted(ctx context.Context, appId int64, sortBy string, sortDesc bool, limit int, offset int) (*podio.ItemList, error) {
+	ret := c.record("GetItemsSorted", []interface{}{ctx, appId, sortBy, sortDesc, limit, offset}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("GetItemsSorted", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemsSorted", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemsByPriority(ctx context.Context, appId int64, priorityExternalId string, ascending bool, limit int) (*podio.ItemList, error) {
+	ret := c.record("GetItemsByPriority", []interface{}{ctx, appId, priorityExternalId, ascending, limit}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("GetItemsByPriority", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemsByPriority", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemsGroupedByCategory(ctx context.Context, appId int64, categoryExternalId string) (map[string][]*podio.Item, error) {
+	ret := c.record("GetItemsGroupedByCategory", []interface{}{ctx, appId, categoryExternalId}, 2)
+	r0, ok := ret[0].(map[string][]*podio.Item)
+	checkReturn("GetItemsGroupedByCategory", 0, ret[0], ok, "map[string][]*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemsGroupedByCategory", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemsWithView(ctx context.Context, appId int64, params map[string]interface{}, view string) (*podio.ItemList, error) {
+	ret := c.record("GetItemsWithView", []interface{}{ctx, appId, params, view}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("GetItemsWithView", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemsWithView", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) FilterItemsByView(ctx context.Context, appId int64, viewId int64, params map[string]interface{}) (*podio.ItemList, error) {
+	ret := c.record("FilterItemsByView", []interface{}{ctx, appId, viewId, params}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("FilterItemsByView", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("FilterItemsByView", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemCount(ctx context.Context, appId int64) (int, error) {
+	ret := c.record("GetItemCount", []interface{}{ctx, appId}, 2)
+	r0, ok := ret[0].(int)
+	checkReturn("GetItemCount", 0, ret[0], ok, "int")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemCount", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemCountsBySpace(ctx context.Context, spaceId int64) (map[int64]int, error) {
+	ret := c.record("GetItemCountsBySpace", []interface{}{ctx, spaceId}, 2)
+	r0, ok := ret[0].(map[int64]int)
+	checkReturn("GetItemCountsBySpace", 0, ret[0], ok, "map[int64]int")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemCountsBySpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemsAssignedToUser(ctx context.Context, appId int64, profileId int64) (*podio.ItemList, error) {
+	ret := c.record("GetItemsAssignedToUser", []interface{}{ctx, appId, profileId}, 2)
+	r0, ok := ret[0].(*podio.ItemList)
+	checkReturn("GetItemsAssignedToUser", 0, ret[0], ok, "*podio.ItemList")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemsAssignedToUser", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) AllItems(ctx context.Context, appId int64, params map[string]interface{}) ([]*podio.Item, error) {
+	ret := c.record("AllItems", []interface{}{ctx, appId, params}, 2)
+	r0, ok := ret[0].([]*podio.Item)
+	checkReturn("AllItems", 0, ret[0], ok, "[]*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("AllItems", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) AllViewItemsFull(ctx context.Context, appId int64, viewId int64) ([]*podio.Item, error) {
+	ret := c.record("AllViewItemsFull", []interface{}{ctx, appId, viewId}, 2)
+	r0, ok := ret[0].([]*podio.Item)
+	checkReturn("AllViewItemsFull", 0, ret[0], ok, "[]*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("AllViewItemsFull", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemsAsChannel(ctx context.Context, appId int64, filter *podio.ItemFilterRequest) (<-chan *podio.Item, <-chan error) {
+	ret := c.record("GetItemsAsChannel", []interface{}{ctx, appId, filter}, 2)
+	r0, ok := ret[0].(<-chan *podio.Item)
+	checkReturn("GetItemsAsChannel", 0, ret[0], ok, "<-chan *podio.Item")
+	r1, ok := ret[1].(<-chan error)
+	checkReturn("GetItemsAsChannel", 1, ret[1], ok, "<-chan error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemByAppItemId(appId int64, formattedAppItemId string) (*podio.Item, error) {
+	ret := c.record("GetItemByAppItemId", []interface{}{appId, formattedAppItemId}, 2)
+	r0, ok := ret[0].(*podio.Item)
+	checkReturn("GetItemByAppItemId", 0, ret[0], ok, "*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemByAppItemId", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemByExternalID(appId int64, externalId string) (*podio.Item, error) {
+	ret := c.record("GetItemByExternalID", []interface{}{appId, externalId}, 2)
+	r0, ok := ret[0].(*podio.Item)
+	checkReturn("GetItemByExternalID", 0, ret[0], ok, "*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemByExternalID", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItem(itemId int64) (*podio.Item, error) {
+	ret := c.record("GetItem", []interface{}{itemId}, 2)
+	r0, ok := ret[0].(*podio.Item)
+	checkReturn("GetItem", 0, ret[0], ok, "*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItem", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemWithCache(ctx context.Context, itemId int64) (*podio.Item, error) {
+	ret := c.record("GetItemWithCache", []interface{}{ctx, itemId}, 2)
+	r0, ok := ret[0].(*podio.Item)
+	checkReturn("GetItemWithCache", 0, ret[0], ok, "*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemWithCache", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemCreator(ctx context.Context, itemId int64) (*podio.ByLine, podio.Time, error) {
+	ret := c.record("GetItemCreator", []interface{}{ctx, itemId}, 3)
+	r0, ok := ret[0].(*podio.ByLine)
+	checkReturn("GetItemCreator", 0, ret[0], ok, "*podio.ByLine")
+	r1, ok := ret[1].(podio.Time)
+	checkReturn("GetItemCreator", 1, ret[1], ok, "podio.Time")
+	r2, ok := ret[2].(error)
+	checkReturn("GetItemCreator", 2, ret[2], ok, "error")
+	return r0, r1, r2
+}
+
+func (c *RecordingClient) GetItemLastEditor(ctx context.Context, itemId int64) (*podio.ByLine, podio.Time, error) {
+	ret := c.record("GetItemLastEditor", []interface{}{ctx, itemId}, 3)
+	r0, ok := ret[0].(*podio.ByLine)
+	checkReturn("GetItemLastEditor", 0, ret[0], ok, "*podio.ByLine")
+	r1, ok := ret[1].(podio.Time)
+	checkReturn("GetItemLastEditor", 1, ret[1], ok, "podio.Time")
+	r2, ok := ret[2].(error)
+	checkReturn("GetItemLastEditor", 2, ret[2], ok, "error")
+	return r0, r1, r2
+}
+
+func (c *RecordingClient) GetItemImages(ctx context.Context, itemId int64) ([]*podio.File, error) {
+	ret := c.record("GetItemImages", []interface{}{ctx, itemId}, 2)
+	r0, ok := ret[0].([]*podio.File)
+	checkReturn("GetItemImages", 0, ret[0], ok, "[]*podio.File")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemImages", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateItem(appId int, externalId string, fieldValues map[string]interface{}) (int64, error) {
+	ret := c.record("CreateItem", []interface{}{appId, externalId, fieldValues}, 2)
+	r0, ok := ret[0].(int64)
+	checkReturn("CreateItem", 0, ret[0], ok, "int64")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateItem", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateItem(itemId int, fieldValues map[string]interface{}) error {
+	ret := c.record("UpdateItem", []interface{}{itemId, fieldValues}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateItem", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetNotifications(ctx context.Context, opts *podio.NotificationListOptions) ([]*podio.Notification, error) {
+	ret := c.record("GetNotifications", []interface{}{ctx, opts}, 2)
+	r0, ok := ret[0].([]*podio.Notification)
+	checkReturn("GetNotifications", 0, ret[0], ok, "[]*podio.Notification")
+	r1, ok := ret[1].(error)
+	checkReturn("GetNotifications", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) MarkNotificationRead(ctx context.Context, notificationId int64) error {
+	ret := c.record("MarkNotificationRead", []interface{}{ctx, notificationId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("MarkNotificationRead", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) MarkAllNotificationsRead(ctx context.Context) error {
+	ret := c.record("MarkAllNotificationsRead", []interface{}{ctx}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("MarkAllNotificationsRead", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetPersonalNotificationCount(ctx context.Context) (int, error) {
+	ret := c.record("GetPersonalNotificationCount", []interface{}{ctx}, 2)
+	r0, ok := ret[0].(int)
+	checkReturn("GetPersonalNotificationCount", 0, ret[0], ok, "int")
+	r1, ok := ret[1].(error)
+	checkReturn("GetPersonalNotificationCount", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetUnreadNotificationCount(ctx context.Context) (int, error) {
+	ret := c.record("GetUnreadNotificationCount", []interface{}{ctx}, 2)
+	r0, ok := ret[0].(int)
+	checkReturn("GetUnreadNotificationCount", 0, ret[0], ok, "int")
+	r1, ok := ret[1].(error)
+	checkReturn("GetUnreadNotificationCount", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetNotificationCount(ctx context.Context) (*podio.NotificationCount, error) {
+	ret := c.record("GetNotificationCount", []interface{}{ctx}, 2)
+	r0, ok := ret[0].(*podio.NotificationCount)
+	checkReturn("GetNotificationCount", 0, ret[0], ok, "*podio.NotificationCount")
+	r1, ok := ret[1].(error)
+	checkReturn("GetNotificationCount", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrganizations() ([]podio.Organization, error) {
+	ret := c.record("GetOrganizations", []interface{}{}, 2)
+	r0, ok := ret[0].([]podio.Organization)
+	checkReturn("GetOrganizations", 0, ret[0], ok, "[]podio.Organization")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrganizations", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrganization(id int64) (*podio.Organization, error) {
+	ret := c.record("GetOrganization", []interface{}{id}, 2)
+	r0, ok := ret[0].(*podio.Organization)
+	checkReturn("GetOrganization", 0, ret[0], ok, "*podio.Organization")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrganization", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrganizationBySlug(slug string) (*podio.Organization, error) {
+	ret := c.record("GetOrganizationBySlug", []interface{}{slug}, 2)
+	r0, ok := ret[0].(*podio.Organization)
+	checkReturn("GetOrganizationBySlug", 0, ret[0], ok, "*podio.Organization")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrganizationBySlug", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateOrganization(ctx context.Context, req *podio.CreateOrgRequest) (*podio.Organization, error) {
+	ret := c.record("CreateOrganization", []interface{}{ctx, req}, 2)
+	r0, ok := ret[0].(*podio.Organization)
+	checkReturn("CreateOrganization", 0, ret[0], ok, "*podio.Organization")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateOrganization", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateOrganization(ctx context.Context, orgId int64, req *podio.UpdateOrgRequest) (*podio.Organization, error) {
+	ret := c.record("UpdateOrganization", []interface{}{ctx, orgId, req}, 2)
+	r0, ok := ret[0].(*podio.Organization)
+	checkReturn("UpdateOrganization", 0, ret[0], ok, "*podio.Organization")
+	r1, ok := ret[1].(error)
+	checkReturn("UpdateOrganization", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrgMembers(ctx context.Context, orgId int64) ([]*podio.OrgMember, error) {
+	ret := c.record("GetOrgMembers", []interface{}{ctx, orgId}, 2)
+	r0, ok := ret[0].([]*podio.OrgMember)
+	checkReturn("GetOrgMembers", 0, ret[0], ok, "[]*podio.OrgMember")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrgMembers", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) InviteToOrganization(ctx context.Context, orgId int64, mails []string, role podio.MemberRole) error {
+	ret := c.record("InviteToOrganization", []interface{}{ctx, orgId, mails, role}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("InviteToOrganization", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) RemoveOrgMember(ctx context.Context, orgId int64, userId int64) error {
+	ret := c.record("RemoveOrgMember", []interface{}{ctx, orgId, userId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("RemoveOrgMember", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetOrganizationQuota(ctx context.Context, orgId int64) (*podio.OrganizationQuota, error) {
+	ret := c.record("GetOrganizationQuota", []interface{}{ctx, orgId}, 2)
+	r0, ok := ret[0].(*podio.OrganizationQuota)
+	checkReturn("GetOrganizationQuota", 0, ret[0], ok, "*podio.OrganizationQuota")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrganizationQuota", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetQuotaRemaining(ctx context.Context, orgId int64) (float64, error) {
+	ret := c.record("GetQuotaRemaining", []interface{}{ctx, orgId}, 2)
+	r0, ok := ret[0].(float64)
+	checkReturn("GetQuotaRemaining", 0, ret[0], ok, "float64")
+	r1, ok := ret[1].(error)
+	checkReturn("GetQuotaRemaining", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppsGroupedBySpace(ctx context.Context, orgId int64) (map[int64][]podio.App, error) {
+	ret := c.record("GetAppsGroupedBySpace", []interface{}{ctx, orgId}, 2)
+	r0, ok := ret[0].(map[int64][]podio.App)
+	checkReturn("GetAppsGroupedBySpace", 0, ret[0], ok, "map[int64][]podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppsGroupedBySpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrgHierarchy(ctx context.Context, orgId int64) (*podio.OrgHierarchy, error) {
+	ret := c.record("GetOrgHierarchy", []interface{}{ctx, orgId}, 2)
+	r0, ok := ret[0].(*podio.OrgHierarchy)
+	checkReturn("GetOrgHierarchy", 0, ret[0], ok, "*podio.OrgHierarchy")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrgHierarchy", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAllOrganizationItems(ctx context.Context, orgId int64, filter *podio.ItemFilterRequest) ([]*podio.Item, error) {
+	ret := c.record("GetAllOrganizationItems", []interface{}{ctx, orgId, filter}, 2)
+	r0, ok := ret[0].([]*podio.Item)
+	checkReturn("GetAllOrganizationItems", 0, ret[0], ok, "[]*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAllOrganizationItems", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetRatings(ctx context.Context, refType string, refId int64, ratingType string) (*podio.RatingSummary, error) {
+	ret := c.record("GetRatings", []interface{}{ctx, refType, refId, ratingType}, 2)
+	r0, ok := ret[0].(*podio.RatingSummary)
+	checkReturn("GetRatings", 0, ret[0], ok, "*podio.RatingSummary")
+	r1, ok := ret[1].(error)
+	checkReturn("GetRatings", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateRating(ctx context.Context, refType string, refId int64, ratingType string, value int) error {
+	ret := c.record("CreateRating", []interface{}{ctx, refType, refId, ratingType, value}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("CreateRating", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteRating(ctx context.Context, refType string, refId int64, ratingType string) error {
+	ret := c.record("DeleteRating", []interface{}{ctx, refType, refId, ratingType}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteRating", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetReminder(ctx context.Context, refType string, refId int64) (*podio.Reminder, error) {
+	ret := c.record("GetReminder", []interface{}{ctx, refType, refId}, 2)
+	r0, ok := ret[0].(*podio.Reminder)
+	checkReturn("GetReminder", 0, ret[0], ok, "*podio.Reminder")
+	r1, ok := ret[1].(error)
+	checkReturn("GetReminder", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateReminder(ctx context.Context, refType string, refId int64, remindDelta int) error {
+	ret := c.record("CreateReminder", []interface{}{ctx, refType, refId, remindDelta}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("CreateReminder", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) UpdateReminder(ctx context.Context, refType string, refId int64, remindDelta int) error {
+	ret := c.record("UpdateReminder", []interface{}{ctx, refType, refId, remindDelta}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateReminder", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteReminder(ctx context.Context, refType string, refId int64) error {
+	ret := c.record("DeleteReminder", []interface{}{ctx, refType, refId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteReminder", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GlobalSearch(ctx context.Context, query string, opts *podio.SearchOptions) (*podio.SearchResult, error) {
+	ret := c.record("GlobalSearch", []interface{}{ctx, query, opts}, 2)
+	r0, ok := ret[0].(*podio.SearchResult)
+	checkReturn("GlobalSearch", 0, ret[0], ok, "*podio.SearchResult")
+	r1, ok := ret[1].(error)
+	checkReturn("GlobalSearch", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) SearchItems(ctx context.Context, query string, appId int64, opts *podio.SearchOptions) (*podio.SearchResult, error) {
+	ret := c.record("SearchItems", []interface{}{ctx, query, appId, opts}, 2)
+	r0, ok := ret[0].(*podio.SearchResult)
+	checkReturn("SearchItems", 0, ret[0], ok, "*podio.SearchResult")
+	r1, ok := ret[1].(error)
+	checkReturn("SearchItems", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) SearchApps(ctx context.Context, query string, orgId int64) ([]*podio.App, error) {
+	ret := c.record("SearchApps", []interface{}{ctx, query, orgId}, 2)
+	r0, ok := ret[0].([]*podio.App)
+	checkReturn("SearchApps", 0, ret[0], ok, "[]*podio.App")
+	r1, ok := ret[1].(error)
+	checkReturn("SearchApps", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) SearchSpaces(ctx context.Context, query string, orgId int64) ([]*podio.Space, error) {
+	ret := c.record("SearchSpaces", []interface{}{ctx, query, orgId}, 2)
+	r0, ok := ret[0].([]*podio.Space)
+	checkReturn("SearchSpaces", 0, ret[0], ok, "[]*podio.Space")
+	r1, ok := ret[1].(error)
+	checkReturn("SearchSpaces", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetSpaces(orgId int64) ([]podio.Space, error) {
+	ret := c.record("GetSpaces", []interface{}{orgId}, 2)
+	r0, ok := ret[0].([]podio.Space)
+	checkReturn("GetSpaces", 0, ret[0], ok, "[]podio.Space")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaces", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetSpace(id int64) (*podio.Space, error) {
+	ret := c.record("GetSpace", []interface{}{id}, 2)
+	r0, ok := ret[0].(*podio.Space)
+	checkReturn("GetSpace", 0, ret[0], ok, "*podio.Space")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetSpaceByOrgIdAndSlug(orgId int64, slug string) (*podio.Space, error) {
+	ret := c.record("GetSpaceByOrgIdAndSlug", []interface{}{orgId, slug}, 2)
+	r0, ok := ret[0].(*podio.Space)
+	checkReturn("GetSpaceByOrgIdAndSlug", 0, ret[0], ok, "*podio.Space")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaceByOrgIdAndSlug", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateSpace(ctx context.Context, orgId int64, req *podio.CreateSpaceRequest) (*podio.Space, error) {
+	ret := c.record("CreateSpace", []interface{}{ctx, orgId, req}, 2)
+	r0, ok := ret[0].(*podio.Space)
+	checkReturn("CreateSpace", 0, ret[0], ok, "*podio.Space")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateSpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateSpace(ctx context.Context, spaceId int64, req *podio.UpdateSpaceRequest) (*podio.Space, error) {
+	ret := c.record("UpdateSpace", []interface{}{ctx, spaceId, req}, 2)
+	r0, ok := ret[0].(*podio.Space)
+	checkReturn("UpdateSpace", 0, ret[0], ok, "*podio.Space")
+	r1, ok := ret[1].(error)
+	checkReturn("UpdateSpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) DeleteSpace(ctx context.Context, spaceId int64) error {
+	ret := c.record("DeleteSpace", []interface{}{ctx, spaceId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteSpace", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetSpaceMembers(ctx context.Context, spaceId int64) ([]*podio.SpaceMember, error) {
+	ret := c.record("GetSpaceMembers", []interface{}{ctx, spaceId}, 2)
+	r0, ok := ret[0].([]*podio.SpaceMember)
+	checkReturn("GetSpaceMembers", 0, ret[0], ok, "[]*podio.SpaceMember")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaceMembers", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetActiveMembersInSpace(ctx context.Context, spaceId int64, since time.Time) ([]*podio.SpaceMember, error) {
+	ret := c.record("GetActiveMembersInSpace", []interface{}{ctx, spaceId, since}, 2)
+	r0, ok := ret[0].([]*podio.SpaceMember)
+	checkReturn("GetActiveMembersInSpace", 0, ret[0], ok, "[]*podio.SpaceMember")
+	r1, ok := ret[1].(error)
+	checkReturn("GetActiveMembersInSpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) InviteToSpace(ctx context.Context, spaceId int64, req *podio.SpaceInviteRequest) error {
+	ret := c.record("InviteToSpace", []interface{}{ctx, spaceId, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("InviteToSpace", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) RemoveSpaceMember(ctx context.Context, spaceId int64, userId int64) error {
+	ret := c.record("RemoveSpaceMember", []interface{}{ctx, spaceId, userId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("RemoveSpaceMember", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) UpdateSpaceMember(ctx context.Context, spaceId int64, userId int64, role podio.MemberRole) error {
+	ret := c.record("UpdateSpaceMember", []interface{}{ctx, spaceId, userId, role}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateSpaceMember", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetSpaceApplications(ctx context.Context, spaceId int64) ([]podio.Application, error) {
+	ret := c.record("GetSpaceApplications", []interface{}{ctx, spaceId}, 2)
+	r0, ok := ret[0].([]podio.Application)
+	checkReturn("GetSpaceApplications", 0, ret[0], ok, "[]podio.Application")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaceApplications", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) AcceptSpaceApplication(ctx context.Context, spaceId int64, applicationId int64) error {
+	ret := c.record("AcceptSpaceApplication", []interface{}{ctx, spaceId, applicationId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("AcceptSpaceApplication", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetSpaceActivity(ctx context.Context, spaceId int64, limit int, offset int) ([]*podio.Activity, error) {
+	ret := c.record("GetSpaceActivity", []interface{}{ctx, spaceId, limit, offset}, 2)
+	r0, ok := ret[0].([]*podio.Activity)
+	checkReturn("GetSpaceActivity", 0, ret[0], ok, "[]*podio.Activity")
+	r1, ok := ret[1].(error)
+	checkReturn("GetSpaceActivity", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetItemActivityCount(ctx context.Context, itemId int64) (int, error) {
+	ret := c.record("GetItemActivityCount", []interface{}{ctx, itemId}, 2)
+	r0, ok := ret[0].(int)
+	checkReturn("GetItemActivityCount", 0, ret[0], ok, "int")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemActivityCount", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrgActivity(ctx context.Context, orgId int64, limit int, offset int) ([]*podio.Activity, error) {
+	ret := c.record("GetOrgActivity", []interface{}{ctx, orgId, limit, offset}, 2)
+	r0, ok := ret[0].([]*podio.Activity)
+	checkReturn("GetOrgActivity", 0, ret[0], ok, "[]*podio.Activity")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrgActivity", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetOrganizationRecentItems(ctx context.Context, orgId int64, limit int) ([]*podio.Item, error) {
+	ret := c.record("GetOrganizationRecentItems", []interface{}{ctx, orgId, limit}, 2)
+	r0, ok := ret[0].([]*podio.Item)
+	checkReturn("GetOrganizationRecentItems", 0, ret[0], ok, "[]*podio.Item")
+	r1, ok := ret[1].(error)
+	checkReturn("GetOrganizationRecentItems", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) Subscribe(ctx context.Context, refType string, refId int64) error {
+	ret := c.record("Subscribe", []interface{}{ctx, refType, refId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("Subscribe", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) Unsubscribe(ctx context.Context, refType string, refId int64) error {
+	ret := c.record("Unsubscribe", []interface{}{ctx, refType, refId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("Unsubscribe", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) StopWatchingItem(ctx context.Context, itemId int64) error {
+	ret := c.record("StopWatchingItem", []interface{}{ctx, itemId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("StopWatchingItem", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetItemSubscribers(ctx context.Context, itemId int64) ([]*podio.Profile, error) {
+	ret := c.record("GetItemSubscribers", []interface{}{ctx, itemId}, 2)
+	r0, ok := ret[0].([]*podio.Profile)
+	checkReturn("GetItemSubscribers", 0, ret[0], ok, "[]*podio.Profile")
+	r1, ok := ret[1].(error)
+	checkReturn("GetItemSubscribers", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTaskSubscribers(ctx context.Context, taskId int64) ([]*podio.Profile, error) {
+	ret := c.record("GetTaskSubscribers", []interface{}{ctx, taskId}, 2)
+	r0, ok := ret[0].([]*podio.Profile)
+	checkReturn("GetTaskSubscribers", 0, ret[0], ok, "[]*podio.Profile")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTaskSubscribers", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasks(ctx context.Context, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasks", []interface{}{ctx, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasks", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasks", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) AllTasks(ctx context.Context, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("AllTasks", []interface{}{ctx, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("AllTasks", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("AllTasks", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetMyUpcomingTasks(ctx context.Context, withinDays int) ([]*podio.Task, error) {
+	ret := c.record("GetMyUpcomingTasks", []interface{}{ctx, withinDays}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetMyUpcomingTasks", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetMyUpcomingTasks", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetRecurringTasks(ctx context.Context) ([]*podio.Task, error) {
+	ret := c.record("GetRecurringTasks", []interface{}{ctx}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetRecurringTasks", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetRecurringTasks", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetCompletedTasksByUser(ctx context.Context, userId int64, from time.Time, to time.Time) ([]*podio.Task, error) {
+	ret := c.record("GetCompletedTasksByUser", []interface{}{ctx, userId, from, to}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetCompletedTasksByUser", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetCompletedTasksByUser", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAverageTaskCompletionTime(ctx context.Context, appId int64, from time.Time, to time.Time) (time.Duration, error) {
+	ret := c.record("GetAverageTaskCompletionTime", []interface{}{ctx, appId, from, to}, 2)
+	r0, ok := ret[0].(time.Duration)
+	checkReturn("GetAverageTaskCompletionTime", 0, ret[0], ok, "time.Duration")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAverageTaskCompletionTime", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksForRef(ctx context.Context, refType string, refId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksForRef", []interface{}{ctx, refType, refId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksForRef", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksForRef", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksForItem(ctx context.Context, itemId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksForItem", []interface{}{ctx, itemId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksForItem", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksForItem", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksByItem(ctx context.Context, itemId int64) ([]*podio.Task, error) {
+	ret := c.record("GetTasksByItem", []interface{}{ctx, itemId}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksByItem", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksByItem", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksByApp(ctx context.Context, appId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksByApp", []interface{}{ctx, appId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksByApp", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksByApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksBySpace(ctx context.Context, spaceId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksBySpace", []interface{}{ctx, spaceId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksBySpace", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksBySpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksByOrg(ctx context.Context, orgId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksByOrg", []interface{}{ctx, orgId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksByOrg", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksByOrg", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksForApp(ctx context.Context, appId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksForApp", []interface{}{ctx, appId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksForApp", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksForApp", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTasksForSpace(ctx context.Context, spaceId int64, opts *podio.TaskListOptions) ([]*podio.Task, error) {
+	ret := c.record("GetTasksForSpace", []interface{}{ctx, spaceId, opts}, 2)
+	r0, ok := ret[0].([]*podio.Task)
+	checkReturn("GetTasksForSpace", 0, ret[0], ok, "[]*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTasksForSpace", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetTask(ctx context.Context, taskId int64) (*podio.Task, error) {
+	ret := c.record("GetTask", []interface{}{ctx, taskId}, 2)
+	r0, ok := ret[0].(*podio.Task)
+	checkReturn("GetTask", 0, ret[0], ok, "*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTask", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateTask(ctx context.Context, req *podio.CreateTaskRequest) (*podio.Task, error) {
+	ret := c.record("CreateTask", []interface{}{ctx, req}, 2)
+	r0, ok := ret[0].(*podio.Task)
+	checkReturn("CreateTask", 0, ret[0], ok, "*podio.Task")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateTask", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateTask(ctx context.Context, taskId int64, req *podio.UpdateTaskRequest) error {
+	ret := c.record("UpdateTask", []interface{}{ctx, taskId, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateTask", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteTask(ctx context.Context, taskId int64) error {
+	ret := c.record("DeleteTask", []interface{}{ctx, taskId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteTask", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) CompleteTask(ctx context.Context, taskId int64) error {
+	ret := c.record("CompleteTask", []interface{}{ctx, taskId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("CompleteTask", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) UncompleteTask(ctx context.Context, taskId int64) error {
+	ret := c.record("UncompleteTask", []interface{}{ctx, taskId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UncompleteTask", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) AssignTask(ctx context.Context, taskId int64, profileId int64) error {
+	ret := c.record("AssignTask", []interface{}{ctx, taskId, profileId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("AssignTask", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetTaskLabels(ctx context.Context) ([]*podio.TaskLabel, error) {
+	ret := c.record("GetTaskLabels", []interface{}{ctx}, 2)
+	r0, ok := ret[0].([]*podio.TaskLabel)
+	checkReturn("GetTaskLabels", 0, ret[0], ok, "[]*podio.TaskLabel")
+	r1, ok := ret[1].(error)
+	checkReturn("GetTaskLabels", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateTaskLabel(ctx context.Context, text string, color string) (*podio.TaskLabel, error) {
+	ret := c.record("CreateTaskLabel", []interface{}{ctx, text, color}, 2)
+	r0, ok := ret[0].(*podio.TaskLabel)
+	checkReturn("CreateTaskLabel", 0, ret[0], ok, "*podio.TaskLabel")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateTaskLabel", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateTaskLabel(ctx context.Context, labelId int64, text string, color string) error {
+	ret := c.record("UpdateTaskLabel", []interface{}{ctx, labelId, text, color}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateTaskLabel", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteTaskLabel(ctx context.Context, labelId int64) error {
+	ret := c.record("DeleteTaskLabel", []interface{}{ctx, labelId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteTaskLabel", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetCurrentUser(ctx context.Context) (*podio.User, error) {
+	ret := c.record("GetCurrentUser", []interface{}{ctx}, 2)
+	r0, ok := ret[0].(*podio.User)
+	checkReturn("GetCurrentUser", 0, ret[0], ok, "*podio.User")
+	r1, ok := ret[1].(error)
+	checkReturn("GetCurrentUser", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetUserProfile(ctx context.Context, userId int64) (*podio.Profile, error) {
+	ret := c.record("GetUserProfile", []interface{}{ctx, userId}, 2)
+	r0, ok := ret[0].(*podio.Profile)
+	checkReturn("GetUserProfile", 0, ret[0], ok, "*podio.Profile")
+	r1, ok := ret[1].(error)
+	checkReturn("GetUserProfile", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateUserProfile(ctx context.Context, req *podio.UpdateProfileRequest) error {
+	ret := c.record("UpdateUserProfile", []interface{}{ctx, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateUserProfile", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) GetCurrentUserID(ctx context.Context) (int64, error) {
+	ret := c.record("GetCurrentUserID", []interface{}{ctx}, 2)
+	r0, ok := ret[0].(int64)
+	checkReturn("GetCurrentUserID", 0, ret[0], ok, "int64")
+	r1, ok := ret[1].(error)
+	checkReturn("GetCurrentUserID", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetAppViews(ctx context.Context, appId int64) ([]*podio.AppView, error) {
+	ret := c.record("GetAppViews", []interface{}{ctx, appId}, 2)
+	r0, ok := ret[0].([]*podio.AppView)
+	checkReturn("GetAppViews", 0, ret[0], ok, "[]*podio.AppView")
+	r1, ok := ret[1].(error)
+	checkReturn("GetAppViews", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) GetView(ctx context.Context, appId int64, viewId int64) (*podio.AppView, error) {
+	ret := c.record("GetView", []interface{}{ctx, appId, viewId}, 2)
+	r0, ok := ret[0].(*podio.AppView)
+	checkReturn("GetView", 0, ret[0], ok, "*podio.AppView")
+	r1, ok := ret[1].(error)
+	checkReturn("GetView", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) CreateAppView(ctx context.Context, appId int64, req *podio.AppViewRequest) (int64, error) {
+	ret := c.record("CreateAppView", []interface{}{ctx, appId, req}, 2)
+	r0, ok := ret[0].(int64)
+	checkReturn("CreateAppView", 0, ret[0], ok, "int64")
+	r1, ok := ret[1].(error)
+	checkReturn("CreateAppView", 1, ret[1], ok, "error")
+	return r0, r1
+}
+
+func (c *RecordingClient) UpdateAppView(ctx context.Context, viewId int64, req *podio.AppViewRequest) error {
+	ret := c.record("UpdateAppView", []interface{}{ctx, viewId, req}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("UpdateAppView", 0, ret[0], ok, "error")
+	return r0
+}
+
+func (c *RecordingClient) DeleteAppView(ctx context.Context, viewId int64) error {
+	ret := c.record("DeleteAppView", []interface{}{ctx, viewId}, 1)
+	r0, ok := ret[0].(error)
+	checkReturn("DeleteAppView", 0, ret[0], ok, "error")
+	return r0
+}
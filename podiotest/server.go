@@ -0,0 +1,218 @@
+package podiotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/andreas/podio-go"
+)
+
+// RecordedRequest is a request made to a Server
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   []byte
+}
+
+// Server is a fake Podio API for tests. It serves the objects added to it, such as
+// items and organizations, and the responses registered with Handle. Other requests
+// get a Podio not_found error. It is safe for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	items    map[int64]*podio.Item
+	orgs     []*podio.Organization
+	spaces   []*podio.Space
+	apps     []*podio.App
+	handlers map[string]http.HandlerFunc // by method and path
+	calls    []RecordedRequest
+}
+
+// NewServer starts a Server, which is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		items:    map[int64]*podio.Item{},
+		handlers: map[string]http.HandlerFunc{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// URL returns the API root of the server
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Client returns a client sending its requests to the server
+func (s *Server) Client() *podio.Client {
+	return podio.NewClient(&podio.AuthToken{AccessToken: "podiotest"}, podio.WithBaseURL(s.server.URL))
+}
+
+// Calls returns the requests made to the server so far, in the order they were made
+func (s *Server) Calls() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]RecordedRequest(nil), s.calls...)
+}
+
+// Handle makes the server respond to requests with the given method and path, without
+// the query, with handler. It takes precedence over the added objects.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[method+" "+path] = handler
+}
+
+// AddItem makes the server return item from GET /item/{item_id}
+func (s *Server) AddItem(item *podio.Item) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[item.Id] = item
+}
+
+// AddOrg makes the server return org from GET /org/{org_id} and include it in GET /org
+func (s *Server) AddOrg(org *podio.Organization) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.orgs = append(s.orgs, org)
+}
+
+// AddSpace makes the server return space from GET /space/{space_id} and include it in
+// the spaces of its organization, GET /org/{org_id}/space
+func (s *Server) AddSpace(space *podio.Space) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.spaces = append(s.spaces, space)
+}
+
+// AddApp makes the server return app from GET /app/{app_id} and include it in the apps
+// of its space, GET /app/space/{space_id}
+func (s *Server) AddApp(app *podio.App) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.apps = append(s.apps, app)
+}
+
+// routes of the objects added to a server. Each route is given the ids in its path
+// and returns the object to respond with, or nil if there is none.
+var routes = []struct {
+	pattern *regexp.Regexp
+	find    func(s *Server, id int64) interface{}
+}{
+	{regexp.MustCompile(`^/item/(\d+)$`), func(s *Server, id int64) interface{} {
+		if item, ok := s.items[id]; ok {
+			return item
+		}
+		return nil
+	}},
+	{regexp.MustCompile(`^/org/?$`), func(s *Server, _ int64) interface{} {
+		orgs := []*podio.Organization{}
+		return append(orgs, s.orgs...)
+	}},
+	{regexp.MustCompile(`^/org/(\d+)$`), func(s *Server, id int64) interface{} {
+		for _, org := range s.orgs {
+			if org.Id == id {
+				return org
+			}
+		}
+		return nil
+	}},
+	{regexp.MustCompile(`^/org/(\d+)/space$`), func(s *Server, id int64) interface{} {
+		spaces := []*podio.Space{}
+		for _, space := range s.spaces {
+			if space.OrgId == id {
+				spaces = append(spaces, space)
+			}
+		}
+		return spaces
+	}},
+	{regexp.MustCompile(`^/space/(\d+)$`), func(s *Server, id int64) interface{} {
+		for _, space := range s.spaces {
+			if space.Id == id {
+				return space
+			}
+		}
+		return nil
+	}},
+	{regexp.MustCompile(`^/app/(\d+)$`), func(s *Server, id int64) interface{} {
+		for _, app := range s.apps {
+			if app.Id == id {
+				return app
+			}
+		}
+		return nil
+	}},
+	{regexp.MustCompile(`^/app/space/(\d+)$`), func(s *Server, id int64) interface{} {
+		apps := []*podio.App{}
+		for _, app := range s.apps {
+			if int64(app.SpaceId) == id {
+				apps = append(apps, app)
+			}
+		}
+		return apps
+	}},
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+
+	s.mu.Lock()
+	s.calls = append(s.calls, RecordedRequest{Method: req.Method, Path: req.URL.Path, Query: req.URL.RawQuery, Body: body})
+	handler := s.handlers[req.Method+" "+req.URL.Path]
+	s.mu.Unlock()
+
+	if handler != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		handler(w, req)
+		return
+	}
+
+	if req.Method == "GET" {
+		if object := s.find(req.URL.Path); object != nil {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(object)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	fmt.Fprintf(w, `{"error": "not_found", "error_description": %q}`, "No fake response for "+req.Method+" "+req.URL.Path)
+}
+
+// find returns the added object served at path, if any
+func (s *Server) find(path string) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, route := range routes {
+		match := route.pattern.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+
+		var id int64
+		if len(match) > 1 {
+			id, _ = strconv.ParseInt(match[1], 10, 64)
+		}
+		return route.find(s, id)
+	}
+	return nil
+}
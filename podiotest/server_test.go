@@ -0,0 +1,81 @@
+package podiotest
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerGetItem(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer(t)
+	server.AddItem(&podio.Item{Id: 582709679, Title: "Offer", Link: "https://podio.com/podio/sales/apps/deals/items/1"})
+
+	client := server.Client()
+	item, err := client.GetItem(582709679)
+	r.NoError(err)
+	r.Equal(int64(582709679), item.Id)
+	r.Equal("Offer", item.Title)
+	r.Equal("https://podio.com/podio/sales/apps/deals/items/1", item.Link)
+
+	_, err = client.GetItem(1)
+	r.True(errors.Is(err, podio.ErrNotFound))
+
+	r.Equal([]RecordedRequest{
+		{Method: "GET", Path: "/item/582709679", Query: "fields=files", Body: []byte{}},
+		{Method: "GET", Path: "/item/1", Query: "fields=files", Body: []byte{}},
+	}, server.Calls())
+}
+
+func TestServerOrgsSpacesAndApps(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer(t)
+	server.AddOrg(&podio.Organization{Id: 736, Name: "Podio"})
+	server.AddSpace(&podio.Space{Id: 2720177, OrgId: 736, Name: "Sales"})
+	server.AddSpace(&podio.Space{Id: 2720178, OrgId: 737, Name: "Other"})
+	server.AddApp(&podio.App{Id: 18166054, SpaceId: 2720177, Name: "Deals"})
+
+	client := server.Client()
+
+	orgs, err := client.GetOrganizations()
+	r.NoError(err)
+	r.Len(orgs, 1)
+	r.Equal("Podio", orgs[0].Name)
+
+	spaces, err := client.GetSpaces(736)
+	r.NoError(err)
+	r.Len(spaces, 1)
+	r.Equal("Sales", spaces[0].Name)
+
+	apps, err := client.GetApps(2720177)
+	r.NoError(err)
+	r.Len(apps, 1)
+	r.Equal("Deals", apps[0].Name)
+
+	app, err := client.GetApp(18166054)
+	r.NoError(err)
+	r.Equal("Deals", app.Name)
+}
+
+func TestServerHandle(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer(t)
+	server.Handle("POST", "/comment/item/582709679/", func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"value": "Looks good"}`, string(body))
+		w.Write([]byte(`{"comment_id": 475900923, "value": "Looks good"}`))
+	})
+
+	comment, err := server.Client().Comment("item", 582709679, "Looks good", nil)
+	r.NoError(err)
+	r.Equal(int64(475900923), comment.Id)
+	r.Equal(`{"value":"Looks good"}`, string(server.Calls()[0].Body))
+}
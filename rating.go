@@ -0,0 +1,83 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// Types of ratings, for use with GetRatings, CreateRating and DeleteRating
+const (
+	RatingTypeApproved = "approved" // 1 approves, 0 disapproves
+	RatingTypeRSVP     = "rsvp"     // 0 attends, 1 does not, 2 maybe
+	RatingTypeFiveStar = "fivestar" // 1 to 5 stars
+	RatingTypeYesNo    = "yesno"    // 1 is yes, 0 is no
+	RatingTypeThumbs   = "thumbs"   // 1 is thumbs up, 0 is thumbs down
+	RatingTypeLike     = "like"     // 1 likes
+)
+
+// RatingSummary holds the ratings of one type on an object. Counts holds the number of
+// ratings given for each value; UserRating is the value given by the authenticated
+// user, or nil if the user has not rated the object.
+type RatingSummary struct {
+	Counts     map[int]int
+	Average    float64
+	UserRating *int
+}
+
+func ratingPath(refType string, refId int64, ratingType string) string {
+	return fmt.Sprintf("/rating/%s/%d/%s", refType, refId, ratingType)
+}
+
+// GetRatings returns the ratings of type ratingType given to an object, e.g. the
+// approvals of an item.
+//
+// https://developers.podio.com/doc/ratings/get-ratings-22375
+func (client *Client) GetRatings(ctx context.Context, refType string, refId int64, ratingType string) (*RatingSummary, error) {
+	path := ratingPath(refType, refId, ratingType)
+
+	rsp := &struct {
+		Counts map[int]struct {
+			Total int `json:"total"`
+		} `json:"counts"`
+		Average float64 `json:"average"`
+	}{}
+	if err := client.requestContext(ctx, "GET", path, nil, nil, rsp); err != nil {
+		return nil, err
+	}
+
+	// https://developers.podio.com/doc/ratings/get-rating-own-84128
+	self := &struct {
+		Value *int `json:"value"`
+	}{}
+	if err := client.requestContext(ctx, "GET", path+"/self", nil, nil, self); err != nil {
+		return nil, err
+	}
+
+	summary := &RatingSummary{
+		Counts:     make(map[int]int, len(rsp.Counts)),
+		Average:    rsp.Average,
+		UserRating: self.Value,
+	}
+	for value, count := range rsp.Counts {
+		summary.Counts[value] = count.Total
+	}
+	return summary, nil
+}
+
+// CreateRating rates an object on behalf of the authenticated user, replacing any
+// earlier rating of the same type by the user.
+//
+// https://developers.podio.com/doc/ratings/add-rating-22373
+func (client *Client) CreateRating(ctx context.Context, refType string, refId int64, ratingType string, value int) error {
+	params := map[string]interface{}{
+		"value": value,
+	}
+	return client.requestWithParamsContext(ctx, "POST", ratingPath(refType, refId, ratingType), nil, params, nil)
+}
+
+// DeleteRating removes the rating of type ratingType given by the authenticated user.
+//
+// https://developers.podio.com/doc/ratings/remove-rating-22342
+func (client *Client) DeleteRating(ctx context.Context, refType string, refId int64, ratingType string) error {
+	return client.requestContext(ctx, "DELETE", ratingPath(refType, refId, ratingType), nil, nil, nil)
+}
@@ -0,0 +1,85 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRatings(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		switch req.URL.Path {
+		case "/rating/item/582709679/approved":
+			w.Write([]byte(`{
+				"average": 0.75,
+				"counts": {
+					"0": {"total": 1, "users": [{"user_id": 2468975, "name": "Brian Stengaard"}]},
+					"1": {"total": 3, "users": []}
+				}
+			}`))
+		case "/rating/item/582709679/approved/self":
+			w.Write([]byte(`{"value": 1}`))
+		default:
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+	})
+
+	summary, err := client.GetRatings(context.Background(), "item", 582709679, RatingTypeApproved)
+	r.NoError(err)
+	r.Equal(map[int]int{0: 1, 1: 3}, summary.Counts)
+	r.Equal(0.75, summary.Average)
+	r.NotNil(summary.UserRating)
+	r.Equal(1, *summary.UserRating)
+}
+
+func TestGetRatingsNotRated(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/rating/item/582709679/like/self" {
+			w.Write([]byte(`{"value": null}`))
+			return
+		}
+		w.Write([]byte(`{"counts": {}}`))
+	})
+
+	summary, err := client.GetRatings(context.Background(), "item", 582709679, RatingTypeLike)
+	r.NoError(err)
+	r.Empty(summary.Counts)
+	r.Nil(summary.UserRating)
+}
+
+func TestCreateRating(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/rating/item/582709679/fivestar", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"value": 4}`, string(body))
+
+		w.Write([]byte(`{"rating_id": 13572}`))
+	})
+
+	r.NoError(client.CreateRating(context.Background(), "item", 582709679, RatingTypeFiveStar, 4))
+}
+
+func TestDeleteRating(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/rating/status/96941/like", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteRating(context.Background(), "status", 96941, RatingTypeLike))
+}
@@ -0,0 +1,44 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// Reminder makes Podio notify the authenticated user before an object, e.g. a task,
+// is due. RemindDelta is the number of minutes before the due date.
+type Reminder struct {
+	Id          int64 `json:"reminder_id"`
+	RemindDelta int   `json:"remind_delta"`
+}
+
+func reminderPath(refType string, refId int64) string {
+	return fmt.Sprintf("/reminder/%s/%d", refType, refId)
+}
+
+// https://developers.podio.com/doc/reminders/get-reminder-3415569
+func (client *Client) GetReminder(ctx context.Context, refType string, refId int64) (reminder *Reminder, err error) {
+	err = client.requestContext(ctx, "GET", reminderPath(refType, refId), nil, nil, &reminder)
+	return
+}
+
+// https://developers.podio.com/doc/reminders/create-reminder-3315055
+func (client *Client) CreateReminder(ctx context.Context, refType string, refId int64, remindDelta int) error {
+	params := map[string]interface{}{
+		"remind_delta": remindDelta,
+	}
+	return client.requestWithParamsContext(ctx, "POST", reminderPath(refType, refId), nil, params, nil)
+}
+
+// https://developers.podio.com/doc/reminders/update-reminder-3315117
+func (client *Client) UpdateReminder(ctx context.Context, refType string, refId int64, remindDelta int) error {
+	params := map[string]interface{}{
+		"remind_delta": remindDelta,
+	}
+	return client.requestWithParamsContext(ctx, "PUT", reminderPath(refType, refId), nil, params, nil)
+}
+
+// https://developers.podio.com/doc/reminders/delete-reminder-3315169
+func (client *Client) DeleteReminder(ctx context.Context, refType string, refId int64) error {
+	return client.requestContext(ctx, "DELETE", reminderPath(refType, refId), nil, nil, nil)
+}
@@ -0,0 +1,56 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReminder(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/reminder/task/71844916", req.URL.Path)
+		w.Write([]byte(`{"reminder_id": 1034, "remind_delta": 30}`))
+	})
+
+	reminder, err := client.GetReminder(context.Background(), "task", 71844916)
+	r.NoError(err)
+	r.Equal(&Reminder{Id: 1034, RemindDelta: 30}, reminder)
+}
+
+func TestCreateAndUpdateReminder(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	r.NoError(client.CreateReminder(ctx, "task", 71844916, 30))
+	r.NoError(client.UpdateReminder(ctx, "item", 582709679, 1440))
+	r.Equal([]string{
+		`POST /reminder/task/71844916 {"remind_delta":30}`,
+		`PUT /reminder/item/582709679 {"remind_delta":1440}`,
+	}, requests)
+}
+
+func TestDeleteReminder(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/reminder/task/71844916", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteReminder(context.Background(), "task", 71844916))
+}
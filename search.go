@@ -0,0 +1,145 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// SearchOptions limits the results of a search. Zero values are left out and the
+// Podio defaults apply.
+type SearchOptions struct {
+	RefType string // only return results of this type: item, task, app, space, profile, ...
+	Limit   int
+	Offset  int
+}
+
+func (opts *SearchOptions) query(query string) string {
+	values := url.Values{
+		"query":  {query},
+		"counts": {"true"},
+	}
+	if opts != nil {
+		if opts.RefType != "" {
+			values.Set("ref_type", opts.RefType)
+		}
+		if opts.Limit > 0 {
+			values.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			values.Set("offset", strconv.Itoa(opts.Offset))
+		}
+	}
+	return "?" + values.Encode()
+}
+
+// SearchHit is a single result of a search
+type SearchHit struct {
+	Type      string `json:"type"` // item, task, app, space, profile, ...
+	Id        int64  `json:"id"`
+	Title     string `json:"title"`
+	Link      string `json:"link"`
+	CreatedBy ByLine `json:"created_by"`
+	CreatedOn Time   `json:"created_on"`
+	App       *App   `json:"app"`   // the app of an item, if any
+	Space     *Space `json:"space"` // the space of the result, if any
+}
+
+// SearchResult holds the results of a search. Total is the number of hits of all types,
+// including those beyond the limit of the search.
+//
+// Items, Apps, Spaces and Contacts hold the hits of each type in the order they were found.
+// Search results are summaries, so only the ids, titles (as names) and links of these
+// objects are set, along with the app and space of items.
+type SearchResult struct {
+	Total    int
+	Hits     []*SearchHit
+	Items    []*Item
+	Apps     []*App
+	Spaces   []*Space
+	Contacts []*Contact
+}
+
+func newSearchResult(counts map[string]int, hits []*SearchHit) *SearchResult {
+	result := &SearchResult{Hits: hits}
+	for _, count := range counts {
+		result.Total += count
+	}
+
+	for _, hit := range hits {
+		switch hit.Type {
+		case "item":
+			item := &Item{Id: hit.Id, Title: hit.Title, Link: hit.Link, CreatedBy: hit.CreatedBy, CreatedOn: hit.CreatedOn}
+			if hit.App != nil {
+				item.App = *hit.App
+			}
+			if hit.Space != nil {
+				item.Space = *hit.Space
+			}
+			result.Items = append(result.Items, item)
+		case "app":
+			result.Apps = append(result.Apps, &App{Id: hit.Id, Name: hit.Title, Link: hit.Link})
+		case "space":
+			result.Spaces = append(result.Spaces, &Space{Id: hit.Id, Name: hit.Title, URL: hit.Link})
+		case "profile":
+			result.Contacts = append(result.Contacts, &Contact{ProfileId: int(hit.Id), Name: hit.Title, Link: hit.Link})
+		}
+	}
+	return result
+}
+
+func (client *Client) search(ctx context.Context, path, query string, opts *SearchOptions) (*SearchResult, error) {
+	rsp := &struct {
+		Counts  map[string]int `json:"counts"`
+		Results []*SearchHit   `json:"results"`
+	}{}
+	err := client.requestContext(ctx, "GET", path+opts.query(query), nil, nil, rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSearchResult(rsp.Counts, rsp.Results), nil
+}
+
+// GlobalSearch searches everything the authenticated user has access to. opts may be nil.
+//
+// https://developers.podio.com/doc/search/search-globally-v2-4234951
+func (client *Client) GlobalSearch(ctx context.Context, query string, opts *SearchOptions) (*SearchResult, error) {
+	return client.search(ctx, "/search/v2", query, opts)
+}
+
+// SearchItems searches the items of an app. opts may be nil; its RefType is ignored.
+//
+// https://developers.podio.com/doc/search/search-in-app-v2-4234954
+func (client *Client) SearchItems(ctx context.Context, query string, appId int64, opts *SearchOptions) (*SearchResult, error) {
+	itemOpts := SearchOptions{}
+	if opts != nil {
+		itemOpts = *opts
+	}
+	itemOpts.RefType = "item"
+
+	return client.search(ctx, fmt.Sprintf("/search/app/%d/v2", appId), query, &itemOpts)
+}
+
+// SearchApps searches the apps in an organization
+//
+// https://developers.podio.com/doc/search/search-in-organization-v2-4234949
+func (client *Client) SearchApps(ctx context.Context, query string, orgId int64) ([]*App, error) {
+	result, err := client.search(ctx, fmt.Sprintf("/search/org/%d/v2", orgId), query, &SearchOptions{RefType: "app"})
+	if err != nil {
+		return nil, err
+	}
+	return result.Apps, nil
+}
+
+// SearchSpaces searches the spaces in an organization
+//
+// https://developers.podio.com/doc/search/search-in-organization-v2-4234949
+func (client *Client) SearchSpaces(ctx context.Context, query string, orgId int64) ([]*Space, error) {
+	result, err := client.search(ctx, fmt.Sprintf("/search/org/%d/v2", orgId), query, &SearchOptions{RefType: "space"})
+	if err != nil {
+		return nil, err
+	}
+	return result.Spaces, nil
+}
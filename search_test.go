@@ -0,0 +1,84 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalSearch(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/search/v2?counts=true&limit=10&query=offer", req.URL.String())
+		w.Write([]byte(`{
+			"counts": {"item": 12, "app": 1, "space": 1, "profile": 1},
+			"results": [
+				{"type": "item", "id": 582709679, "title": "Offer", "link": "https://podio.com/podio/sales/apps/deals/items/1",
+				 "app": {"app_id": 18166054, "name": "Deals"}, "space": {"space_id": 2720177, "name": "Sales"}},
+				{"type": "app", "id": 18166054, "title": "Offers", "link": "https://podio.com/podio/sales/apps/offers"},
+				{"type": "space", "id": 2720177, "title": "Offer team", "link": "https://podio.com/podio/offer-team"},
+				{"type": "profile", "id": 140798621, "title": "Offer Manager", "link": "https://podio.com/users/2468975"},
+				{"type": "task", "id": 71844916, "title": "Send offer"}
+			]
+		}`))
+	})
+
+	result, err := client.GlobalSearch(context.Background(), "offer", &SearchOptions{Limit: 10})
+	r.NoError(err)
+	r.Equal(15, result.Total)
+	r.Len(result.Hits, 5)
+
+	r.Len(result.Items, 1)
+	r.Equal(int64(582709679), result.Items[0].Id)
+	r.Equal("Offer", result.Items[0].Title)
+	r.Equal(int64(18166054), result.Items[0].App.Id)
+	r.Equal("Sales", result.Items[0].Space.Name)
+
+	r.Equal([]*App{{Id: 18166054, Name: "Offers", Link: "https://podio.com/podio/sales/apps/offers"}}, result.Apps)
+	r.Equal([]*Space{{Id: 2720177, Name: "Offer team", URL: "https://podio.com/podio/offer-team"}}, result.Spaces)
+	r.Equal([]*Contact{{ProfileId: 140798621, Name: "Offer Manager", Link: "https://podio.com/users/2468975"}}, result.Contacts)
+}
+
+func TestSearchItems(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/search/app/18166054/v2?counts=true&offset=20&query=offer&ref_type=item", req.URL.String())
+		w.Write([]byte(`{"counts": {"item": 21}, "results": [{"type": "item", "id": 582709679, "title": "Offer"}]}`))
+	})
+
+	result, err := client.SearchItems(context.Background(), "offer", 18166054, &SearchOptions{RefType: "app", Offset: 20})
+	r.NoError(err)
+	r.Equal(21, result.Total)
+	r.Len(result.Items, 1)
+}
+
+func TestSearchAppsAndSpaces(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`{"results": [
+			{"type": "app", "id": 18166054, "title": "Offers"},
+			{"type": "space", "id": 2720177, "title": "Offer team"}
+		]}`))
+	})
+
+	apps, err := client.SearchApps(context.Background(), "offer", 736)
+	r.NoError(err)
+	r.Equal([]*App{{Id: 18166054, Name: "Offers"}}, apps)
+
+	spaces, err := client.SearchSpaces(context.Background(), "offer", 736)
+	r.NoError(err)
+	r.Equal([]*Space{{Id: 2720177, Name: "Offer team"}}, spaces)
+
+	r.Equal([]string{
+		"/search/org/736/v2?counts=true&query=offer&ref_type=app",
+		"/search/org/736/v2?counts=true&query=offer&ref_type=space",
+	}, urls)
+}
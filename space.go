@@ -1,25 +1,77 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SpacePrivacy is the privacy setting of a space
+type SpacePrivacy string
+
+const (
+	// SpacePrivacyOpen spaces can be joined by all employees of the organization
+	SpacePrivacyOpen SpacePrivacy = "open"
+	// SpacePrivacyClosed spaces can only be joined by invitation
+	SpacePrivacyClosed SpacePrivacy = "closed"
+)
+
+// MemberRole is the role of a member of a space or an organization
+type MemberRole string
+
+const (
+	// MemberRoleAdmin members can manage the members, apps and settings
+	MemberRoleAdmin MemberRole = "admin"
+	// MemberRoleRegular members can create and edit apps and items
+	MemberRoleRegular MemberRole = "regular"
+	// MemberRoleLight members can create and edit items, but not apps
+	MemberRoleLight MemberRole = "light"
+	// MemberRoleGuest members can only see what is shared with them
+	MemberRoleGuest MemberRole = "guest"
+)
 
 type Space struct {
-	Id       int64  `json:"space_id"`
-	Name     string `json:"name"`
-	URL      string `json:"url"`
-	URLLabel string `json:"url_label"`
-	OrgId    int64  `json:"org_id"`
-	Push     Push   `json:"push"`
+	Id       int64        `json:"space_id"`
+	Name     string       `json:"name"`
+	URL      string       `json:"url"`
+	URLLabel string       `json:"url_label"`
+	OrgId    int64        `json:"org_id"`
+	Privacy  SpacePrivacy `json:"privacy"`
+	Type     string       `json:"type"` // regular, emp_network or demo
+	Push     Push         `json:"push"`
 }
 
+// GetSpaces returns the spaces of an organization. If the client was created with
+// WithSpaceListCache, the spaces are cached per organization.
 func (client *Client) GetSpaces(orgId int64) (spaces []Space, err error) {
+	return client.getSpacesContext(context.Background(), orgId)
+}
+
+// getSpacesContext is GetSpaces, but the request is aborted when ctx is cancelled
+func (client *Client) getSpacesContext(ctx context.Context, orgId int64) (spaces []Space, err error) {
+	if client.spaceListCache != nil {
+		if cached, ok := client.spaceListCache.get(orgId); ok {
+			return append([]Space(nil), cached.([]Space)...), nil
+		}
+	}
+
 	path := fmt.Sprintf("/org/%d/space", orgId)
-	err = client.Request("GET", path, nil, nil, &spaces)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &spaces)
+
+	if err == nil && client.spaceListCache != nil {
+		client.spaceListCache.set(orgId, append([]Space(nil), spaces...))
+	}
 	return
 }
 
 func (client *Client) GetSpace(id int64) (space *Space, err error) {
+	return client.getSpaceContext(context.Background(), id)
+}
+
+// getSpaceContext is GetSpace, but the request is aborted when ctx is cancelled
+func (client *Client) getSpaceContext(ctx context.Context, id int64) (space *Space, err error) {
 	path := fmt.Sprintf("/space/%d", id)
-	err = client.Request("GET", path, nil, nil, &space)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &space)
 	return
 }
 
@@ -28,3 +80,254 @@ func (client *Client) GetSpaceByOrgIdAndSlug(orgId int64, slug string) (space *S
 	err = client.Request("GET", path, nil, nil, &space)
 	return
 }
+
+// CreateSpaceRequest describes a new space
+type CreateSpaceRequest struct {
+	Name            string
+	Privacy         SpacePrivacy
+	AutoJoin        bool // new employees of the organization join the space automatically
+	PostOnNewApp    bool // post to the stream when an app is added to the space
+	PostOnNewMember bool // post to the stream when a member joins the space
+}
+
+func (req *CreateSpaceRequest) params() map[string]interface{} {
+	params := map[string]interface{}{
+		"name":               req.Name,
+		"auto_join":          req.AutoJoin,
+		"post_on_new_app":    req.PostOnNewApp,
+		"post_on_new_member": req.PostOnNewMember,
+	}
+	if req.Privacy != "" {
+		params["privacy"] = req.Privacy
+	}
+	return params
+}
+
+// CreateSpace creates a space in an organization and returns the new space. Podio only
+// responds with the id of the space, so the space is fetched with a second request; if
+// that request fails, the returned space holds only the id.
+//
+// https://developers.podio.com/doc/spaces/create-space-22390
+func (client *Client) CreateSpace(ctx context.Context, orgId int64, req *CreateSpaceRequest) (*Space, error) {
+	params := req.params()
+	params["org_id"] = orgId
+
+	rsp := &struct {
+		SpaceId int64 `json:"space_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", "/space/", nil, params, rsp)
+	if client.spaceListCache != nil {
+		client.spaceListCache.delete(orgId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	space, err := client.getSpaceContext(ctx, rsp.SpaceId)
+	if err != nil {
+		return &Space{Id: rsp.SpaceId}, err
+	}
+	return space, nil
+}
+
+// UpdateSpaceRequest holds the new settings of a space. Only the settings that are set,
+// i.e. non-empty or non-nil, are changed.
+type UpdateSpaceRequest struct {
+	Name            string
+	Privacy         SpacePrivacy
+	AutoJoin        *bool
+	PostOnNewApp    *bool
+	PostOnNewMember *bool
+}
+
+func (req *UpdateSpaceRequest) params() map[string]interface{} {
+	params := map[string]interface{}{}
+	if req.Name != "" {
+		params["name"] = req.Name
+	}
+	if req.Privacy != "" {
+		params["privacy"] = req.Privacy
+	}
+	if req.AutoJoin != nil {
+		params["auto_join"] = *req.AutoJoin
+	}
+	if req.PostOnNewApp != nil {
+		params["post_on_new_app"] = *req.PostOnNewApp
+	}
+	if req.PostOnNewMember != nil {
+		params["post_on_new_member"] = *req.PostOnNewMember
+	}
+	return params
+}
+
+// UpdateSpace changes the settings of a space and returns the updated space, which is
+// fetched with a second request as Podio does not respond with it.
+//
+// https://developers.podio.com/doc/spaces/update-space-22391
+func (client *Client) UpdateSpace(ctx context.Context, spaceId int64, req *UpdateSpaceRequest) (*Space, error) {
+	path := fmt.Sprintf("/space/%d", spaceId)
+	err := client.requestWithParamsContext(ctx, "PUT", path, nil, req.params(), nil)
+	client.clearSpaceListCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getSpaceContext(ctx, spaceId)
+}
+
+// DeleteSpace deletes a space along with all of its apps and items
+//
+// https://developers.podio.com/doc/spaces/delete-space-22417
+func (client *Client) DeleteSpace(ctx context.Context, spaceId int64) error {
+	path := fmt.Sprintf("/space/%d", spaceId)
+	err := client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+	client.clearSpaceListCache()
+	return err
+}
+
+// clearSpaceListCache drops the cached spaces of all organizations, for changes to a
+// space whose organization is unknown
+func (client *Client) clearSpaceListCache() {
+	if client.spaceListCache != nil {
+		client.spaceListCache.clear()
+	}
+}
+
+// SpaceMember is a member of a space and the role of the member in the space
+type SpaceMember struct {
+	Profile   Profile    `json:"profile"`
+	Role      MemberRole `json:"role"`
+	StartedOn Time       `json:"started_on"` // when the user became a member
+	Employee  bool       `json:"employee"`
+}
+
+// https://developers.podio.com/doc/space-members/get-members-of-space-22395
+func (client *Client) GetSpaceMembers(ctx context.Context, spaceId int64) (members []*SpaceMember, err error) {
+	path := fmt.Sprintf("/space/%d/member/", spaceId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &members)
+	return
+}
+
+// GetActiveMembersInSpace returns the members of a space who have created or updated
+// anything in the space stream since the given time, in the order of GetSpaceMembers.
+//
+// The space stream is paged through, newest first, until it reaches activity older
+// than since. Activity is attributed to the creator of each stream object.
+func (client *Client) GetActiveMembersInSpace(ctx context.Context, spaceId int64, since time.Time) ([]*SpaceMember, error) {
+	active := map[int64]bool{} // user ids
+	for offset := 0; ; offset += streamPageSize {
+		activities, err := client.GetSpaceActivity(ctx, spaceId, streamPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		done := len(activities) < streamPageSize
+		for _, activity := range activities {
+			last := activity.LastUpdateOn.Time
+			if last.IsZero() {
+				last = activity.CreatedOn.Time
+			}
+			if last.Before(since) {
+				done = true
+				continue
+			}
+			if activity.CreatedBy.Type == "user" {
+				active[activity.CreatedBy.Id] = true
+			}
+		}
+
+		if done {
+			break
+		}
+	}
+
+	members, err := client.GetSpaceMembers(ctx, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	activeMembers := []*SpaceMember{}
+	for _, member := range members {
+		if active[int64(member.Profile.UserId)] {
+			activeMembers = append(activeMembers, member)
+		}
+	}
+	return activeMembers, nil
+}
+
+// SpaceInviteRequest describes who to invite to a space and with which role.
+// People who are not yet Podio users can be invited by mail.
+type SpaceInviteRequest struct {
+	Role     MemberRole
+	Message  string // personal message included in the invitation
+	Users    []int64
+	Profiles []int64
+	Mails    []string
+}
+
+// https://developers.podio.com/doc/space-members/add-member-to-space-1066259
+func (client *Client) InviteToSpace(ctx context.Context, spaceId int64, req *SpaceInviteRequest) error {
+	path := fmt.Sprintf("/space/%d/member/", spaceId)
+	params := map[string]interface{}{
+		"role": req.Role,
+	}
+	if req.Message != "" {
+		params["message"] = req.Message
+	}
+	if len(req.Users) > 0 {
+		params["users"] = req.Users
+	}
+	if len(req.Profiles) > 0 {
+		params["profiles"] = req.Profiles
+	}
+	if len(req.Mails) > 0 {
+		params["mails"] = req.Mails
+	}
+
+	return client.requestWithParamsContext(ctx, "POST", path, nil, params, nil)
+}
+
+// RemoveSpaceMember ends the membership of a user in a space
+//
+// https://developers.podio.com/doc/space-members/end-space-memberships-22399
+func (client *Client) RemoveSpaceMember(ctx context.Context, spaceId, userId int64) error {
+	path := fmt.Sprintf("/space/%d/member/%d", spaceId, userId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// UpdateSpaceMember changes the role of a member of a space
+//
+// https://developers.podio.com/doc/space-members/update-space-memberships-22398
+func (client *Client) UpdateSpaceMember(ctx context.Context, spaceId, userId int64, role MemberRole) error {
+	path := fmt.Sprintf("/space/%d/member/%d", spaceId, userId)
+	params := map[string]interface{}{
+		"role": role,
+	}
+
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, params, nil)
+}
+
+// Application is a request from a user to become a member of a space
+type Application struct {
+	Id        int64  `json:"space_member_request_id"`
+	Status    string `json:"status"` // active, accepted or declined
+	CreatedBy ByLine `json:"created_by"`
+	CreatedOn Time   `json:"created_on"`
+}
+
+// GetSpaceApplications returns the requests for membership of a space.
+func (client *Client) GetSpaceApplications(ctx context.Context, spaceId int64) (applications []Application, err error) {
+	path := fmt.Sprintf("/space/%d/member_request/", spaceId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &applications)
+	return
+}
+
+// AcceptSpaceApplication accepts a request for membership of a space, making the user a
+// member. Podio has no endpoint for declining a request; requests that are not accepted
+// stay active.
+//
+// https://developers.podio.com/doc/space-members/accept-a-space-membership-request-6146651
+func (client *Client) AcceptSpaceApplication(ctx context.Context, spaceId, applicationId int64) error {
+	path := fmt.Sprintf("/space/%d/member_request/%d/accept", spaceId, applicationId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
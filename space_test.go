@@ -0,0 +1,287 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSpace(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "GET" {
+			w.Write([]byte(`{"space_id": 2720178, "name": "Sandbox", "privacy": "closed", "org_id": 736}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"org_id": 736,
+			"name": "Sandbox",
+			"privacy": "closed",
+			"auto_join": false,
+			"post_on_new_app": true,
+			"post_on_new_member": false
+		}`, string(body))
+
+		w.Write([]byte(`{"space_id": 2720178, "url": "https://podio.com/podio/sandbox"}`))
+	})
+
+	space, err := client.CreateSpace(context.Background(), 736, &CreateSpaceRequest{
+		Name:         "Sandbox",
+		Privacy:      SpacePrivacyClosed,
+		PostOnNewApp: true,
+	})
+	r.NoError(err)
+	r.Equal(&Space{Id: 2720178, Name: "Sandbox", Privacy: SpacePrivacyClosed, OrgId: 736}, space)
+	r.Equal([]string{"POST /space/", "GET /space/2720178"}, requests)
+}
+
+func TestUpdateSpace(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		r.Equal("/space/2720178", req.URL.Path)
+		if req.Method == "GET" {
+			w.Write([]byte(`{"space_id": 2720178, "name": "Playground", "privacy": "open"}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"name": "Playground",
+			"privacy": "open",
+			"auto_join": true,
+			"post_on_new_app": false
+		}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	autoJoin, postOnNewApp := true, false
+	space, err := client.UpdateSpace(context.Background(), 2720178, &UpdateSpaceRequest{
+		Name:         "Playground",
+		Privacy:      SpacePrivacyOpen,
+		AutoJoin:     &autoJoin,
+		PostOnNewApp: &postOnNewApp,
+	})
+	r.NoError(err)
+	r.Equal(&Space{Id: 2720178, Name: "Playground", Privacy: SpacePrivacyOpen}, space)
+	r.Equal([]string{"PUT /space/2720178", "GET /space/2720178"}, requests)
+}
+
+func TestUpdateSpaceName(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			w.Write([]byte(`{"space_id": 2720178, "name": "Playground"}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"name": "Playground"}`, string(body))
+	})
+
+	_, err := client.UpdateSpace(context.Background(), 2720178, &UpdateSpaceRequest{Name: "Playground"})
+	r.NoError(err)
+}
+
+func TestDeleteSpace(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/space/2720178", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteSpace(context.Background(), 2720178))
+}
+
+func TestGetSpace(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/space/2720177", req.URL.Path)
+		w.Write([]byte(`{"space_id": 2720177, "name": "sandbox", "privacy": "closed", "type": "regular", "org_id": 736}`))
+	})
+
+	space, err := client.GetSpace(2720177)
+	r.NoError(err)
+	r.Equal(SpacePrivacyClosed, space.Privacy)
+	r.Equal("regular", space.Type)
+}
+
+func TestGetSpaceMembers(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/space/2720177/member/", req.URL.Path)
+		w.Write([]byte(`[{
+			"profile": {"profile_id": 140798621, "user_id": 2468975, "name": "Brian Stengaard"},
+			"role": "admin",
+			"started_on": "2014-12-10 15:26:35",
+			"employee": true
+		}]`))
+	})
+
+	members, err := client.GetSpaceMembers(context.Background(), 2720177)
+	r.NoError(err)
+	r.Len(members, 1)
+	r.Equal(140798621, members[0].Profile.ProfileId)
+	r.Equal("Brian Stengaard", members[0].Profile.Name)
+	r.Equal(MemberRoleAdmin, members[0].Role)
+	r.Equal(*parseTime(t, "2014-12-10 15:26:35"), members[0].StartedOn)
+}
+
+func TestGetActiveMembersInSpace(t *testing.T) {
+	r := require.New(t)
+
+	var paths []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.Path)
+		switch req.URL.Path {
+		case "/stream/space/2720177/":
+			w.Write([]byte(`[
+				{"id": 1, "type": "item", "created_by": {"id": 2, "type": "user"}, "created_on": "2017-03-20 10:00:00", "last_update_on": "2017-03-21 10:00:00"},
+				{"id": 2, "type": "status", "created_by": {"id": 18166054, "type": "app"}, "created_on": "2017-03-20 09:00:00"},
+				{"id": 3, "type": "item", "created_by": {"id": 3, "type": "user"}, "created_on": "2017-03-01 10:00:00"}
+			]`))
+		case "/space/2720177/member/":
+			w.Write([]byte(`[
+				{"profile": {"user_id": 1, "name": "Inactive"}, "role": "regular"},
+				{"profile": {"user_id": 2, "name": "Active"}, "role": "admin"},
+				{"profile": {"user_id": 3, "name": "Earlier"}, "role": "regular"}
+			]`))
+		}
+	})
+
+	members, err := client.GetActiveMembersInSpace(context.Background(), 2720177, time.Date(2017, 3, 15, 0, 0, 0, 0, time.UTC))
+	r.NoError(err)
+	r.Len(members, 1)
+	r.Equal("Active", members[0].Profile.Name)
+	r.Equal([]string{"/stream/space/2720177/", "/space/2720177/member/"}, paths)
+}
+
+func TestInviteToSpace(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/space/2720177/member/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"role": "light", "users": [2468975], "mails": ["new@example.com"]}`, string(body))
+	})
+
+	err := client.InviteToSpace(context.Background(), 2720177, &SpaceInviteRequest{
+		Role:  MemberRoleLight,
+		Users: []int64{2468975},
+		Mails: []string{"new@example.com"},
+	})
+	r.NoError(err)
+}
+
+func TestUpdateAndRemoveSpaceMember(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.UpdateSpaceMember(context.Background(), 2720177, 2468975, MemberRoleRegular))
+	r.NoError(client.RemoveSpaceMember(context.Background(), 2720177, 2468975))
+	r.Equal([]string{
+		`PUT /space/2720177/member/2468975 {"role":"regular"}`,
+		`DELETE /space/2720177/member/2468975 `,
+	}, requests)
+}
+
+func TestGetSpaceApplications(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/space/2720177/member_request/", req.URL.Path)
+		w.Write([]byte(`[{
+			"space_member_request_id": 33909,
+			"status": "active",
+			"created_by": {"id": 2468975, "type": "user", "name": "Brian Stengaard"},
+			"created_on": "2017-03-21 23:29:07"
+		}]`))
+	})
+
+	applications, err := client.GetSpaceApplications(context.Background(), 2720177)
+	r.NoError(err)
+	r.Len(applications, 1)
+	r.Equal(int64(33909), applications[0].Id)
+	r.Equal("active", applications[0].Status)
+	r.Equal("Brian Stengaard", applications[0].CreatedBy.Name)
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), applications[0].CreatedOn)
+}
+
+func TestAcceptSpaceApplication(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/space/2720177/member_request/33909/accept", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.AcceptSpaceApplication(context.Background(), 2720177, 33909))
+}
+
+func TestGetSpaceActivity(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/stream/space/2720177/", req.URL.Path)
+		r.Equal("20", req.URL.Query().Get("limit"))
+		r.Equal("40", req.URL.Query().Get("offset"))
+		w.Write([]byte(`[{"id": 582709679, "type": "item", "data": {"item_id": 582709679}, "created_on": "2017-03-21 23:29:07"}]`))
+	})
+
+	activities, err := client.GetSpaceActivity(context.Background(), 2720177, 20, 40)
+	r.NoError(err)
+	r.Len(activities, 1)
+	r.Equal(int64(582709679), activities[0].Id)
+	r.Equal("item", activities[0].Type)
+	r.JSONEq(`{"item_id": 582709679}`, string(activities[0].Data))
+}
+
+func TestGetSpaceActivityDefaultPage(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[]`))
+	})
+
+	ctx := context.Background()
+	_, err := client.GetSpaceActivity(ctx, 2720177, 0, 0)
+	r.NoError(err)
+	_, err = client.GetSpaceActivity(ctx, 2720177, 0, 40)
+	r.NoError(err)
+	r.Equal([]string{"/stream/space/2720177/", "/stream/space/2720177/?offset=40"}, urls)
+}
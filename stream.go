@@ -0,0 +1,116 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Activity is an object in an activity stream, such as an item or a status, along
+// with who created it. The content of Data depends on the Type of the object.
+type Activity struct {
+	Id           int64           `json:"id"`
+	Type         string          `json:"type"`
+	Data         json.RawMessage `json:"data"`
+	Title        string          `json:"title"`
+	Link         string          `json:"link"`
+	CreatedBy    ByLine          `json:"created_by"`
+	CreatedVia   Via             `json:"created_via"`
+	CreatedOn    Time            `json:"created_on"`
+	LastUpdateOn Time            `json:"last_update_on"`
+}
+
+// GetSpaceActivity returns the activity stream of a space, newest first. A limit or
+// offset of 0 is left out of the request, so Podio's default page size applies.
+//
+// https://developers.podio.com/doc/stream/get-space-stream-80039
+func (client *Client) GetSpaceActivity(ctx context.Context, spaceId int64, limit, offset int) (activities []*Activity, err error) {
+	path := fmt.Sprintf("/stream/space/%d/", spaceId)
+
+	values := url.Values{}
+	if limit > 0 {
+		values.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		values.Set("offset", strconv.Itoa(offset))
+	}
+	if len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+
+	err = client.requestContext(ctx, "GET", path, nil, nil, &activities)
+	return
+}
+
+// GetItemActivityCount returns the number of activity entries (comments, edits,
+// ratings, ...) in the stream of an item.
+//
+// Podio has no endpoint returning only the count, so the stream object of the item
+// is fetched and its activity entries are counted.
+//
+// https://developers.podio.com/doc/stream/get-stream-object-80054
+func (client *Client) GetItemActivityCount(ctx context.Context, itemId int64) (int, error) {
+	path := fmt.Sprintf("/stream/item/%d", itemId)
+
+	rsp := &struct {
+		Activity []json.RawMessage `json:"activity"`
+	}{}
+	err := client.requestContext(ctx, "GET", path, nil, nil, rsp)
+
+	return len(rsp.Activity), err
+}
+
+// GetOrgActivity returns the activity stream of an organization, newest first.
+//
+// https://developers.podio.com/doc/stream/get-organization-stream-80038
+func (client *Client) GetOrgActivity(ctx context.Context, orgId int64, limit, offset int) (activities []*Activity, err error) {
+	path := fmt.Sprintf("/stream/org/%d/?limit=%d&offset=%d", orgId, limit, offset)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &activities)
+	return
+}
+
+// streamPageSize is the number of stream objects requested at a time when paging through a stream
+const streamPageSize = 100
+
+// GetOrganizationRecentItems returns the items with the most recent activity across all
+// spaces and apps of an organization, most recently active first. Podio does not rank
+// items by the amount of activity, so this is the order of the organization stream.
+//
+// The items are found by paging through the organization stream until limit
+// items have been found or the stream is exhausted.
+func (client *Client) GetOrganizationRecentItems(ctx context.Context, orgId int64, limit int) ([]*Item, error) {
+	items := []*Item{}
+	seen := map[int64]bool{}
+
+	for offset := 0; len(items) < limit; offset += streamPageSize {
+		activities, err := client.GetOrgActivity(ctx, orgId, streamPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, activity := range activities {
+			if activity.Type != "item" || seen[activity.Id] {
+				continue
+			}
+			seen[activity.Id] = true
+
+			item := &Item{}
+			if err := json.Unmarshal(activity.Data, item); err != nil {
+				return nil, err
+			}
+
+			items = append(items, item)
+			if len(items) == limit {
+				break
+			}
+		}
+
+		if len(activities) < streamPageSize {
+			break
+		}
+	}
+
+	return items, nil
+}
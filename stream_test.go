@@ -0,0 +1,101 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrgActivity(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/stream/org/736/", req.URL.Path)
+		r.Equal("20", req.URL.Query().Get("limit"))
+		r.Equal("40", req.URL.Query().Get("offset"))
+		w.Write([]byte(`[
+			{"id": 582709679, "type": "item", "data": {"item_id": 582709679}, "created_on": "2017-03-21 23:29:07"},
+			{"id": 124071427, "type": "status", "data": {"status_id": 124071427}, "created_on": "2017-03-20 10:00:00"}
+		]`))
+	})
+
+	activities, err := client.GetOrgActivity(context.Background(), 736, 20, 40)
+	r.NoError(err)
+	r.Len(activities, 2)
+	r.Equal(int64(582709679), activities[0].Id)
+	r.Equal("item", activities[0].Type)
+	r.Equal("status", activities[1].Type)
+	r.JSONEq(`{"status_id": 124071427}`, string(activities[1].Data))
+}
+
+func TestGetOrganizationRecentItems(t *testing.T) {
+	r := require.New(t)
+
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/stream/org/736/", req.URL.Path)
+		r.Equal(strconv.Itoa(streamPageSize), req.URL.Query().Get("limit"))
+		offset := req.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		if offset != "0" {
+			w.Write([]byte(`[{"id": 3, "type": "item", "data": {"item_id": 3, "title": "Third"}}]`))
+			return
+		}
+
+		// a full page, with a status and an item seen twice
+		activities := []string{
+			`{"id": 1, "type": "item", "data": {"item_id": 1, "title": "First"}}`,
+			`{"id": 10, "type": "status", "data": {"status_id": 10}}`,
+			`{"id": 1, "type": "item", "data": {"item_id": 1, "title": "First"}}`,
+			`{"id": 2, "type": "item", "data": {"item_id": 2, "title": "Second"}}`,
+		}
+		for len(activities) < streamPageSize {
+			activities = append(activities, fmt.Sprintf(`{"id": %d, "type": "status", "data": {}}`, 100+len(activities)))
+		}
+		w.Write([]byte("[" + strings.Join(activities, ",") + "]"))
+	})
+
+	items, err := client.GetOrganizationRecentItems(context.Background(), 736, 3)
+	r.NoError(err)
+	r.Len(items, 3)
+	r.Equal("First", items[0].Title)
+	r.Equal("Second", items[1].Title)
+	r.Equal("Third", items[2].Title)
+	r.Equal([]string{"0", strconv.Itoa(streamPageSize)}, offsets)
+
+	// the stream ends before limit items are found
+	offsets = nil
+	items, err = client.GetOrganizationRecentItems(context.Background(), 736, 10)
+	r.NoError(err)
+	r.Len(items, 3)
+	r.Equal([]string{"0", strconv.Itoa(streamPageSize)}, offsets)
+}
+
+func TestGetItemActivityCount(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/stream/item/582709679", req.URL.Path)
+		w.Write([]byte(`{
+			"id": 582709679,
+			"type": "item",
+			"activity": [
+				{"type": "comment", "id": 424851989},
+				{"type": "rating", "id": 13012954},
+				{"type": "item", "id": 582709679}
+			]
+		}`))
+	})
+
+	count, err := client.GetItemActivityCount(context.Background(), 582709679)
+	r.NoError(err)
+	r.Equal(3, count)
+}
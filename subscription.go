@@ -0,0 +1,45 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// Subscribe subscribes the user to notifications about a podio object.
+//
+// refType (item, task, ...) and refId identifies the podio object.
+//
+// https://developers.podio.com/doc/subscriptions/subscribe-22409
+func (client *Client) Subscribe(ctx context.Context, refType string, refId int64) error {
+	path := fmt.Sprintf("/subscription/%s/%d", refType, refId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// Unsubscribe stops notifications to the user about a podio object.
+//
+// https://developers.podio.com/doc/subscriptions/unsubscribe-by-reference-22410
+func (client *Client) Unsubscribe(ctx context.Context, refType string, refId int64) error {
+	path := fmt.Sprintf("/subscription/%s/%d", refType, refId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// StopWatchingItem stops notifications to the user about an item.
+func (client *Client) StopWatchingItem(ctx context.Context, itemId int64) error {
+	return client.Unsubscribe(ctx, "item", itemId)
+}
+
+// GetItemSubscribers returns the users who receive notifications about an item.
+func (client *Client) GetItemSubscribers(ctx context.Context, itemId int64) ([]*Profile, error) {
+	return client.getSubscribers(ctx, "item", itemId)
+}
+
+// GetTaskSubscribers returns the users who receive notifications about a task.
+func (client *Client) GetTaskSubscribers(ctx context.Context, taskId int64) ([]*Profile, error) {
+	return client.getSubscribers(ctx, "task", taskId)
+}
+
+func (client *Client) getSubscribers(ctx context.Context, refType string, refId int64) (profiles []*Profile, err error) {
+	path := fmt.Sprintf("/%s/%d/subscriber", refType, refId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &profiles)
+	return
+}
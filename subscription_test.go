@@ -0,0 +1,59 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeAndUnsubscribe(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.Subscribe(context.Background(), "task", 48656984))
+	r.NoError(client.Unsubscribe(context.Background(), "task", 48656984))
+	r.NoError(client.StopWatchingItem(context.Background(), 582709679))
+	r.Equal([]string{
+		"POST /subscription/task/48656984",
+		"DELETE /subscription/task/48656984",
+		"DELETE /subscription/item/582709679",
+	}, requests)
+}
+
+func TestGetItemSubscribers(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/item/582709679/subscriber", req.URL.Path)
+		w.Write([]byte(`[{"user_id": 2468975, "profile_id": 140798621, "type": "user", "name": "Brian Stengaard"}]`))
+	})
+
+	profiles, err := client.GetItemSubscribers(context.Background(), 582709679)
+	r.NoError(err)
+	r.Len(profiles, 1)
+	r.Equal(2468975, profiles[0].UserId)
+	r.Equal("Brian Stengaard", profiles[0].Name)
+}
+
+func TestGetTaskSubscribers(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/task/48656984/subscriber", req.URL.Path)
+		w.Write([]byte(`[{"user_id": 2468975, "profile_id": 140798621, "type": "user", "name": "Brian Stengaard"}]`))
+	})
+
+	profiles, err := client.GetTaskSubscribers(context.Background(), 48656984)
+	r.NoError(err)
+	r.Len(profiles, 1)
+	r.Equal(140798621, profiles[0].ProfileId)
+}
@@ -0,0 +1,513 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TaskStatus is the state of a task
+type TaskStatus string
+
+const (
+	TaskStatusActive    TaskStatus = "active"
+	TaskStatusCompleted TaskStatus = "completed"
+)
+
+// Task describes a Podio task. Ref is the object the task is attached to, if any.
+type Task struct {
+	Id          int64        `json:"task_id"`
+	Text        string       `json:"text"`
+	Description string       `json:"description"`
+	Status      TaskStatus   `json:"status"`
+	Private     bool         `json:"private"`
+	DueOn       *Time        `json:"due_on"` // nil if the task has no due date
+	Responsible *Contact     `json:"responsible"`
+	Ref         *Reference   `json:"ref"`
+	Labels      []*TaskLabel `json:"labels"`
+	Link        string       `json:"link"`
+	CreatedBy   ByLine       `json:"created_by"`
+	CreatedVia  Via          `json:"created_via"`
+	CreatedOn   Time         `json:"created_on"`
+	CompletedBy *ByLine      `json:"completed_by"`
+	CompletedOn *Time        `json:"completed_on"`
+
+	Recurrence *TaskRecurrence `json:"recurrence"` // nil if the task does not repeat
+}
+
+// TaskRecurrence is the schedule of a repeating task, e.g. every second week until the
+// end of the year. A new task is created when the previous one is completed.
+type TaskRecurrence struct {
+	Name   string                 `json:"name"`   // weekly, monthly or yearly
+	Step   int                    `json:"step"`   // the interval, e.g. 2 for every second week
+	Until  string                 `json:"until"`  // last date (YYYY-MM-DD) to repeat on, or empty
+	Config map[string]interface{} `json:"config"` // depends on the name, e.g. the days of the week
+}
+
+// TaskLabel is a coloured label on a task
+type TaskLabel struct {
+	Id    int64  `json:"label_id"`
+	Text  string `json:"text"`
+	Color string `json:"color"` // hex color without the leading #
+}
+
+// TaskListOptions selects the tasks returned by GetTasks. Podio requires at least one
+// of the app, space, org, reference, responsible, created by or completed by filters.
+// Apart from Completed, zero values are left out.
+type TaskListOptions struct {
+	Completed bool // return completed tasks instead of open ones
+
+	App         int64
+	Space       int64
+	Org         int64
+	RefType     string // the reference filter is used if both RefType and RefId are set
+	RefId       int64
+	Responsible int64 // user id
+	CreatedBy   int64 // user id
+	CompletedBy int64 // user id
+	Labels      []int64
+	DueFrom     time.Time // only tasks due on or after this date
+	DueTo       time.Time // only tasks due on or before this date
+
+	CompletedFrom time.Time // only tasks completed on or after this date
+	CompletedTo   time.Time // only tasks completed on or before this date
+
+	SortBy string // created_on, completed_on, due_date or rank
+	Limit  int
+	Offset int
+}
+
+func (opts *TaskListOptions) query() string {
+	values := url.Values{}
+	if opts == nil {
+		opts = &TaskListOptions{}
+	}
+
+	values.Set("completed", strconv.FormatBool(opts.Completed))
+
+	setId := func(key string, id int64) {
+		if id != 0 {
+			values.Set(key, strconv.FormatInt(id, 10))
+		}
+	}
+	setId("app", opts.App)
+	setId("space", opts.Space)
+	setId("org", opts.Org)
+	setId("responsible", opts.Responsible)
+	setId("created_by", opts.CreatedBy)
+	setId("completed_by", opts.CompletedBy)
+
+	if opts.RefType != "" && opts.RefId != 0 {
+		values.Set("reference", fmt.Sprintf("%s:%d", opts.RefType, opts.RefId))
+	}
+	if len(opts.Labels) > 0 {
+		labels := make([]string, len(opts.Labels))
+		for i, id := range opts.Labels {
+			labels[i] = strconv.FormatInt(id, 10)
+		}
+		values.Set("label", strings.Join(labels, ","))
+	}
+	if !opts.DueFrom.IsZero() || !opts.DueTo.IsZero() {
+		values.Set("due_date", dateRange(opts.DueFrom, opts.DueTo))
+	}
+	if !opts.CompletedFrom.IsZero() || !opts.CompletedTo.IsZero() {
+		values.Set("completed_on", dateRange(opts.CompletedFrom, opts.CompletedTo))
+	}
+	if opts.SortBy != "" {
+		values.Set("sort_by", opts.SortBy)
+	}
+	if opts.Limit > 0 {
+		values.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		values.Set("offset", strconv.Itoa(opts.Offset))
+	}
+
+	return "?" + values.Encode()
+}
+
+// dateRange formats a date range filter as Podio expects it, from-to with each date
+// as YYYY-MM-DD. A zero time leaves that end of the range open.
+func dateRange(from, to time.Time) string {
+	format := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02")
+	}
+	return format(from) + "-" + format(to)
+}
+
+// https://developers.podio.com/doc/tasks/get-tasks-77949
+func (client *Client) GetTasks(ctx context.Context, opts *TaskListOptions) (tasks []*Task, err error) {
+	err = client.requestContext(ctx, "GET", "/task/"+opts.query(), nil, nil, &tasks)
+	return
+}
+
+// maxTasksLimit is the largest number of tasks Podio returns in a single request
+const maxTasksLimit = 100
+
+// AllTasks returns all tasks matching opts. It requests the tasks in pages of the
+// largest size Podio allows, so the limit and offset of opts are ignored.
+func (client *Client) AllTasks(ctx context.Context, opts *TaskListOptions) ([]*Task, error) {
+	page := TaskListOptions{}
+	if opts != nil {
+		page = *opts
+	}
+	page.Limit = maxTasksLimit
+
+	tasks := []*Task{}
+	for {
+		page.Offset = len(tasks)
+
+		list, err := client.GetTasks(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, list...)
+		if len(list) < maxTasksLimit {
+			return tasks, nil
+		}
+	}
+}
+
+// GetMyUpcomingTasks returns the open tasks of the authenticated user that are due
+// within the next withinDays days, today included, soonest first.
+func (client *Client) GetMyUpcomingTasks(ctx context.Context, withinDays int) ([]*Task, error) {
+	userId, err := client.GetCurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now()
+	return client.AllTasks(ctx, &TaskListOptions{
+		Responsible: userId,
+		DueFrom:     today,
+		DueTo:       today.AddDate(0, 0, withinDays),
+		SortBy:      "due_date",
+	})
+}
+
+// GetRecurringTasks returns the open tasks of the authenticated user that repeat on
+// a schedule. Podio cannot filter on recurrence, so all open tasks of the user are
+// fetched and filtered.
+func (client *Client) GetRecurringTasks(ctx context.Context) ([]*Task, error) {
+	userId, err := client.GetCurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := client.AllTasks(ctx, &TaskListOptions{Responsible: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	recurring := []*Task{}
+	for _, task := range tasks {
+		if task.Recurrence != nil {
+			recurring = append(recurring, task)
+		}
+	}
+	return recurring, nil
+}
+
+// GetCompletedTasksByUser returns the tasks a user completed between from and to,
+// both dates included.
+func (client *Client) GetCompletedTasksByUser(ctx context.Context, userId int64, from, to time.Time) ([]*Task, error) {
+	return client.AllTasks(ctx, &TaskListOptions{
+		Completed:     true,
+		CompletedBy:   userId,
+		CompletedFrom: from,
+		CompletedTo:   to,
+	})
+}
+
+// GetAverageTaskCompletionTime returns the mean time from creation to completion of the
+// tasks on the items of an app that were completed between from and to, both dates
+// included. It returns 0 if no tasks were completed in the range.
+func (client *Client) GetAverageTaskCompletionTime(ctx context.Context, appId int64, from, to time.Time) (time.Duration, error) {
+	tasks, err := client.GetTasksByApp(ctx, appId, &TaskListOptions{
+		Completed:     true,
+		CompletedFrom: from,
+		CompletedTo:   to,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total time.Duration
+	count := 0
+	for _, task := range tasks {
+		if task.CompletedOn == nil || task.CompletedOn.IsZero() {
+			continue
+		}
+		total += task.CompletedOn.Sub(task.CreatedOn.Time)
+		count++
+	}
+
+	if count == 0 {
+		return 0, nil
+	}
+	return total / time.Duration(count), nil
+}
+
+// GetTasksForRef returns the tasks attached to an object, e.g. an item. opts may be nil;
+// its filters other than completed, labels, limit and offset are ignored by Podio.
+//
+// https://developers.podio.com/doc/tasks/get-tasks-with-reference-22414
+func (client *Client) GetTasksForRef(ctx context.Context, refType string, refId int64, opts *TaskListOptions) (tasks []*Task, err error) {
+	path := fmt.Sprintf("/task/%s/%d/", refType, refId)
+	err = client.requestContext(ctx, "GET", path+opts.query(), nil, nil, &tasks)
+	return
+}
+
+// GetTasksForItem returns the tasks attached to an item. opts may be nil.
+func (client *Client) GetTasksForItem(ctx context.Context, itemId int64, opts *TaskListOptions) ([]*Task, error) {
+	return client.GetTasksForRef(ctx, "item", itemId, opts)
+}
+
+// GetTasksByItem returns all tasks attached to an item, both open and completed, with
+// the open tasks first. Unlike GetTasksForItem, which returns a single page of either the
+// open or the completed tasks, all pages of both are fetched.
+func (client *Client) GetTasksByItem(ctx context.Context, itemId int64) ([]*Task, error) {
+	opts := &TaskListOptions{RefType: "item", RefId: itemId}
+
+	open, err := client.AllTasks(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Completed = true
+	completed, err := client.AllTasks(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(open, completed...), nil
+}
+
+// GetTasksByApp returns all tasks on the items of an app, filtered by opts, which may be
+// nil. Unlike GetTasksForApp, which only returns tasks attached to the app itself, this
+// uses the app filter of GetTasks. The app, limit and offset of opts are ignored.
+func (client *Client) GetTasksByApp(ctx context.Context, appId int64, opts *TaskListOptions) ([]*Task, error) {
+	filter := TaskListOptions{}
+	if opts != nil {
+		filter = *opts
+	}
+	filter.App = appId
+
+	return client.AllTasks(ctx, &filter)
+}
+
+// GetTasksBySpace returns all tasks in a space, filtered by opts, which may be nil.
+// Unlike GetTasksForSpace, which only returns tasks attached to the space itself, this
+// uses the space filter of GetTasks. The space, limit and offset of opts are ignored.
+func (client *Client) GetTasksBySpace(ctx context.Context, spaceId int64, opts *TaskListOptions) ([]*Task, error) {
+	filter := TaskListOptions{}
+	if opts != nil {
+		filter = *opts
+	}
+	filter.Space = spaceId
+
+	return client.AllTasks(ctx, &filter)
+}
+
+// GetTasksByOrg returns all tasks in an organization, filtered by opts, which may be
+// nil. It uses the org filter of GetTasks; the org, limit and offset of opts are ignored.
+func (client *Client) GetTasksByOrg(ctx context.Context, orgId int64, opts *TaskListOptions) ([]*Task, error) {
+	filter := TaskListOptions{}
+	if opts != nil {
+		filter = *opts
+	}
+	filter.Org = orgId
+
+	return client.AllTasks(ctx, &filter)
+}
+
+// GetTasksForApp returns the tasks attached to an app. opts may be nil.
+func (client *Client) GetTasksForApp(ctx context.Context, appId int64, opts *TaskListOptions) ([]*Task, error) {
+	return client.GetTasksForRef(ctx, "app", appId, opts)
+}
+
+// GetTasksForSpace returns the tasks attached to a space. opts may be nil.
+func (client *Client) GetTasksForSpace(ctx context.Context, spaceId int64, opts *TaskListOptions) ([]*Task, error) {
+	return client.GetTasksForRef(ctx, "space", spaceId, opts)
+}
+
+// https://developers.podio.com/doc/tasks/get-task-22413
+func (client *Client) GetTask(ctx context.Context, taskId int64) (task *Task, err error) {
+	path := fmt.Sprintf("/task/%d", taskId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &task)
+	return
+}
+
+// CreateTaskRequest describes a new task. The task is attached to the object given by
+// RefType and RefId if both are set.
+type CreateTaskRequest struct {
+	Text        string
+	Description string
+	Private     bool
+	DueOn       *time.Time
+	Responsible int64 // user id of the user responsible for the task, if not zero
+	LabelIds    []int64
+
+	RefType string // item, status, app, space or conversation
+	RefId   int64
+}
+
+func (req *CreateTaskRequest) params() map[string]interface{} {
+	params := map[string]interface{}{
+		"text":        req.Text,
+		"description": req.Description,
+		"private":     req.Private,
+	}
+	if req.DueOn != nil {
+		params["due_on"] = req.DueOn.UTC().Format(podioLayout)
+	}
+	if req.Responsible != 0 {
+		params["responsible"] = req.Responsible
+	}
+	if len(req.LabelIds) > 0 {
+		params["label_ids"] = req.LabelIds
+	}
+	return params
+}
+
+// CreateTask creates a task and returns it
+//
+// https://developers.podio.com/doc/tasks/create-task-22419
+func (client *Client) CreateTask(ctx context.Context, req *CreateTaskRequest) (task *Task, err error) {
+	path := "/task/"
+	if req.RefType != "" && req.RefId != 0 {
+		path = fmt.Sprintf("/task/%s/%d/", req.RefType, req.RefId)
+	}
+
+	err = client.requestWithParamsContext(ctx, "POST", path, nil, req.params(), &task)
+	return
+}
+
+// UpdateTaskRequest holds the new values of a task. Only the values that are set are
+// changed; the zero value of a field leaves it unchanged.
+type UpdateTaskRequest struct {
+	Text        string
+	Description *string
+	Private     *bool
+	DueOn       *time.Time
+	RemoveDueOn bool // removes the due date of the task, DueOn must be nil
+	Responsible int64
+	LabelIds    []int64 // an empty, non-nil slice removes all labels
+}
+
+func (req *UpdateTaskRequest) params() map[string]interface{} {
+	params := map[string]interface{}{}
+	if req.Text != "" {
+		params["text"] = req.Text
+	}
+	if req.Description != nil {
+		params["description"] = *req.Description
+	}
+	if req.Private != nil {
+		params["private"] = *req.Private
+	}
+	if req.DueOn != nil {
+		params["due_on"] = req.DueOn.UTC().Format(podioLayout)
+	} else if req.RemoveDueOn {
+		params["due_on"] = nil
+	}
+	if req.Responsible != 0 {
+		params["responsible"] = req.Responsible
+	}
+	if req.LabelIds != nil {
+		params["label_ids"] = req.LabelIds
+	}
+	return params
+}
+
+// https://developers.podio.com/doc/tasks/update-task-10583674
+func (client *Client) UpdateTask(ctx context.Context, taskId int64, req *UpdateTaskRequest) error {
+	path := fmt.Sprintf("/task/%d", taskId)
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, req.params(), nil)
+}
+
+// https://developers.podio.com/doc/tasks/delete-task-77179
+func (client *Client) DeleteTask(ctx context.Context, taskId int64) error {
+	path := fmt.Sprintf("/task/%d", taskId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/tasks/complete-task-22432
+func (client *Client) CompleteTask(ctx context.Context, taskId int64) error {
+	path := fmt.Sprintf("/task/%d/complete", taskId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// UncompleteTask reopens a completed task
+//
+// https://developers.podio.com/doc/tasks/incomplete-task-22433
+func (client *Client) UncompleteTask(ctx context.Context, taskId int64) error {
+	path := fmt.Sprintf("/task/%d/incomplete", taskId)
+	return client.requestContext(ctx, "POST", path, nil, nil, nil)
+}
+
+// AssignTask makes the profile with the given id responsible for a task
+//
+// https://developers.podio.com/doc/tasks/assign-task-22412
+func (client *Client) AssignTask(ctx context.Context, taskId, profileId int64) error {
+	path := fmt.Sprintf("/task/%d/assign", taskId)
+	params := map[string]interface{}{
+		"responsible": map[string]interface{}{
+			"type": "profile",
+			"id":   profileId,
+		},
+	}
+
+	return client.requestWithParamsContext(ctx, "POST", path, nil, params, nil)
+}
+
+// https://developers.podio.com/doc/tasks/get-labels-151534
+func (client *Client) GetTaskLabels(ctx context.Context) (labels []*TaskLabel, err error) {
+	err = client.requestContext(ctx, "GET", "/task/label/", nil, nil, &labels)
+	return
+}
+
+// CreateTaskLabel creates a personal task label and returns it. color is a hex color
+// without the leading #.
+//
+// https://developers.podio.com/doc/tasks/create-label-151265
+func (client *Client) CreateTaskLabel(ctx context.Context, text, color string) (*TaskLabel, error) {
+	params := map[string]interface{}{
+		"text":  text,
+		"color": color,
+	}
+
+	rsp := &struct {
+		LabelId int64 `json:"label_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", "/task/label/", nil, params, rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskLabel{Id: rsp.LabelId, Text: text, Color: color}, nil
+}
+
+// https://developers.podio.com/doc/tasks/update-label-151289
+func (client *Client) UpdateTaskLabel(ctx context.Context, labelId int64, text, color string) error {
+	path := fmt.Sprintf("/task/label/%d", labelId)
+	params := map[string]interface{}{
+		"text":  text,
+		"color": color,
+	}
+
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, params, nil)
+}
+
+// https://developers.podio.com/doc/tasks/delete-label-151302
+func (client *Client) DeleteTaskLabel(ctx context.Context, labelId int64) error {
+	path := fmt.Sprintf("/task/label/%d", labelId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
@@ -0,0 +1,572 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTasks(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/task/?completed=false&label=12%2C13&limit=10&responsible=2468975", req.URL.String())
+		w.Write([]byte(`[{
+			"task_id": 71844916,
+			"text": "Follow up",
+			"description": "Call back about the offer",
+			"status": "active",
+			"private": true,
+			"due_on": "2017-03-24 12:00:00",
+			"responsible": {"user_id": 2468975, "name": "Brian Stengaard"},
+			"ref": {"type": "item", "id": 582709679, "title": "Offer"},
+			"labels": [{"label_id": 12, "text": "Sales", "color": "E9E9E9"}],
+			"created_by": {"id": 2468975, "type": "user", "name": "Brian Stengaard"},
+			"created_on": "2017-03-21 23:29:07",
+			"completed_by": null,
+			"completed_on": null
+		}]`))
+	})
+
+	tasks, err := client.GetTasks(context.Background(), &TaskListOptions{Responsible: 2468975, Labels: []int64{12, 13}, Limit: 10})
+	r.NoError(err)
+	r.Len(tasks, 1)
+
+	task := tasks[0]
+	r.Equal(int64(71844916), task.Id)
+	r.Equal("Follow up", task.Text)
+	r.Equal(TaskStatusActive, task.Status)
+	r.True(task.Private)
+	r.Equal(parseTime(t, "2017-03-24 12:00:00"), task.DueOn)
+	r.Equal(2468975, task.Responsible.UserId)
+	r.Equal("item", task.Ref.Type)
+	r.Equal(582709679, task.Ref.Id)
+	r.Equal([]*TaskLabel{{Id: 12, Text: "Sales", Color: "E9E9E9"}}, task.Labels)
+	r.Nil(task.CompletedBy)
+}
+
+func TestAllTasks(t *testing.T) {
+	r := require.New(t)
+
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		r.Equal("100", query.Get("limit"))
+		r.Equal("2720177", query.Get("space"))
+		offsets = append(offsets, query.Get("offset"))
+
+		// 230 tasks in the space, served 100 at a time
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		count := 100
+		if offset == 200 {
+			count = 30
+		}
+		tasks := []*Task{}
+		for i := 0; i < count; i++ {
+			tasks = append(tasks, &Task{Id: int64(offset + i)})
+		}
+		json.NewEncoder(w).Encode(tasks)
+	})
+
+	tasks, err := client.AllTasks(context.Background(), &TaskListOptions{Space: 2720177, Limit: 5, Offset: 10})
+	r.NoError(err)
+	r.Len(tasks, 230)
+	r.Equal(int64(229), tasks[229].Id)
+	r.Equal([]string{"", "100", "200"}, offsets)
+}
+
+func TestGetMyUpcomingTasks(t *testing.T) {
+	r := require.New(t)
+
+	today := time.Now()
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/user/":
+			w.Write([]byte(`{"user_id": 2468975}`))
+		case "/task/":
+			query := req.URL.Query()
+			r.Equal("false", query.Get("completed"))
+			r.Equal("2468975", query.Get("responsible"))
+			r.Equal(today.Format("2006-01-02")+"-"+today.AddDate(0, 0, 7).Format("2006-01-02"), query.Get("due_date"))
+			r.Equal("due_date", query.Get("sort_by"))
+			w.Write([]byte(`[{"task_id": 71844916}]`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	tasks, err := client.GetMyUpcomingTasks(context.Background(), 7)
+	r.NoError(err)
+	r.Len(tasks, 1)
+}
+
+func TestGetMyUpcomingTasksCancelled(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s", req.URL.Path)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetMyUpcomingTasks(ctx, 7)
+	r.True(errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+}
+
+func TestTaskListOptionsDueDate(t *testing.T) {
+	r := require.New(t)
+
+	from := time.Date(2017, 3, 21, 0, 0, 0, 0, time.UTC)
+	r.Equal("?completed=false&due_date=2017-03-21-", (&TaskListOptions{DueFrom: from}).query())
+	r.Equal("?completed=false&due_date=-2017-03-21", (&TaskListOptions{DueTo: from}).query())
+}
+
+func TestGetRecurringTasks(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/user/":
+			w.Write([]byte(`{"user_id": 2468975}`))
+		case "/task/":
+			r.Equal("2468975", req.URL.Query().Get("responsible"))
+			w.Write([]byte(`[
+				{"task_id": 1, "recurrence": null},
+				{"task_id": 2, "recurrence": {"name": "weekly", "step": 2, "until": "2017-12-31", "config": {"days": ["monday"]}}}
+			]`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	tasks, err := client.GetRecurringTasks(context.Background())
+	r.NoError(err)
+	r.Len(tasks, 1)
+	r.Equal(int64(2), tasks[0].Id)
+	r.Equal(&TaskRecurrence{
+		Name:   "weekly",
+		Step:   2,
+		Until:  "2017-12-31",
+		Config: map[string]interface{}{"days": []interface{}{"monday"}},
+	}, tasks[0].Recurrence)
+}
+
+func TestGetRecurringTasksCancelled(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s", req.URL.Path)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetRecurringTasks(ctx)
+	r.True(errors.Is(err, context.Canceled), "Expected context.Canceled, got %v", err)
+}
+
+func TestGetTasksForRef(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"task_id": 71844916}]`))
+	})
+
+	ctx := context.Background()
+	tasks, err := client.GetTasksForItem(ctx, 582709679, nil)
+	r.NoError(err)
+	r.Len(tasks, 1)
+
+	_, err = client.GetTasksForApp(ctx, 18166054, &TaskListOptions{Completed: true, Limit: 10, Offset: 20})
+	r.NoError(err)
+
+	_, err = client.GetTasksForSpace(ctx, 2720177, &TaskListOptions{Labels: []int64{12}})
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/task/item/582709679/?completed=false",
+		"/task/app/18166054/?completed=true&limit=10&offset=20",
+		"/task/space/2720177/?completed=false&label=12",
+	}, urls)
+}
+
+func TestGetTasksByItem(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		if req.URL.Query().Get("completed") == "true" {
+			w.Write([]byte(`[{"task_id": 2, "status": "completed"}]`))
+			return
+		}
+		w.Write([]byte(`[{"task_id": 1, "status": "active"}]`))
+	})
+
+	tasks, err := client.GetTasksByItem(context.Background(), 582709679)
+	r.NoError(err)
+	r.Len(tasks, 2)
+	r.Equal(int64(1), tasks[0].Id)
+	r.Equal(int64(2), tasks[1].Id)
+
+	r.Equal([]string{
+		"/task/?completed=false&limit=100&reference=item%3A582709679",
+		"/task/?completed=true&limit=100&reference=item%3A582709679",
+	}, urls)
+}
+
+func TestGetTasksByApp(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"task_id": 71844916}]`))
+	})
+
+	tasks, err := client.GetTasksByApp(context.Background(), 18166054, &TaskListOptions{App: 1, Responsible: 2468975, Limit: 5})
+	r.NoError(err)
+	r.Len(tasks, 1)
+
+	_, err = client.GetTasksByApp(context.Background(), 18166054, nil)
+	r.NoError(err)
+
+	r.Equal([]string{
+		"/task/?app=18166054&completed=false&limit=100&responsible=2468975",
+		"/task/?app=18166054&completed=false&limit=100",
+	}, urls)
+}
+
+func TestGetTasksBySpace(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"task_id": 71844916}]`))
+	})
+
+	tasks, err := client.GetTasksBySpace(context.Background(), 2720177, &TaskListOptions{Completed: true, SortBy: "completed_on"})
+	r.NoError(err)
+	r.Len(tasks, 1)
+	r.Equal([]string{"/task/?completed=true&limit=100&sort_by=completed_on&space=2720177"}, urls)
+}
+
+func TestGetTasksByOrg(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"task_id": 71844916}]`))
+	})
+
+	tasks, err := client.GetTasksByOrg(context.Background(), 736, nil)
+	r.NoError(err)
+	r.Len(tasks, 1)
+	r.Equal([]string{"/task/?completed=false&limit=100&org=736"}, urls)
+}
+
+func TestGetCompletedTasksByUser(t *testing.T) {
+	r := require.New(t)
+
+	var urls []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		urls = append(urls, req.URL.String())
+		w.Write([]byte(`[{"task_id": 71844916, "status": "completed", "completed_on": "2017-03-22 10:00:00"}]`))
+	})
+
+	from := time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 3, 31, 0, 0, 0, 0, time.UTC)
+	tasks, err := client.GetCompletedTasksByUser(context.Background(), 2468975, from, to)
+	r.NoError(err)
+	r.Len(tasks, 1)
+	r.Equal(TaskStatusCompleted, tasks[0].Status)
+
+	r.Equal([]string{
+		"/task/?completed=true&completed_by=2468975&completed_on=2017-03-01-2017-03-31&limit=100",
+	}, urls)
+}
+
+func TestGetAverageTaskCompletionTime(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/task/?app=18166054&completed=true&completed_on=2017-03-01-2017-03-31&limit=100", req.URL.String())
+		w.Write([]byte(`[
+			{"task_id": 1, "created_on": "2017-03-20 10:00:00", "completed_on": "2017-03-20 12:00:00"},
+			{"task_id": 2, "created_on": "2017-03-20 10:00:00", "completed_on": "2017-03-21 14:00:00"},
+			{"task_id": 3, "created_on": "2017-03-20 10:00:00", "completed_on": null}
+		]`))
+	})
+
+	from := time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 3, 31, 0, 0, 0, 0, time.UTC)
+	avg, err := client.GetAverageTaskCompletionTime(context.Background(), 18166054, from, to)
+	r.NoError(err)
+	r.Equal(15*time.Hour, avg)
+}
+
+func TestGetAverageTaskCompletionTimeNoTasks(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+
+	avg, err := client.GetAverageTaskCompletionTime(context.Background(), 18166054, time.Time{}, time.Now())
+	r.NoError(err)
+	r.Equal(time.Duration(0), avg)
+}
+
+func TestGetTask(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/task/71844916", req.URL.Path)
+		w.Write([]byte(`{"task_id": 71844916, "text": "Follow up", "status": "completed", "due_on": null, "completed_on": "2017-03-22 10:00:00"}`))
+	})
+
+	task, err := client.GetTask(context.Background(), 71844916)
+	r.NoError(err)
+	r.Equal(TaskStatusCompleted, task.Status)
+	r.Nil(task.DueOn)
+	r.Equal(parseTime(t, "2017-03-22 10:00:00"), task.CompletedOn)
+}
+
+func TestCreateTask(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/task/item/582709679/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"text": "Follow up",
+			"description": "",
+			"private": false,
+			"due_on": "2017-03-24 12:00:00",
+			"responsible": 2468975
+		}`, string(body))
+
+		w.Write([]byte(`{"task_id": 71844916, "text": "Follow up"}`))
+	})
+
+	dueOn := time.Date(2017, 3, 24, 12, 0, 0, 0, time.UTC)
+	task, err := client.CreateTask(context.Background(), &CreateTaskRequest{
+		Text:        "Follow up",
+		DueOn:       &dueOn,
+		Responsible: 2468975,
+		RefType:     "item",
+		RefId:       582709679,
+	})
+	r.NoError(err)
+	r.Equal(int64(71844916), task.Id)
+}
+
+func TestUpdateTask(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("PUT", req.Method)
+		r.Equal("/task/71844916", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"text": "Follow up tomorrow",
+			"description": "",
+			"private": true,
+			"due_on": null,
+			"label_ids": [12]
+		}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	description, private := "", true
+	err := client.UpdateTask(context.Background(), 71844916, &UpdateTaskRequest{
+		Text:        "Follow up tomorrow",
+		Description: &description,
+		Private:     &private,
+		RemoveDueOn: true,
+		LabelIds:    []int64{12},
+	})
+	r.NoError(err)
+}
+
+func TestUpdateTaskText(t *testing.T) {
+	r := require.New(t)
+
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// the due date and labels are left as they are
+	r.NoError(client.UpdateTask(context.Background(), 71844916, &UpdateTaskRequest{Text: "Follow up tomorrow"}))
+	r.JSONEq(`{"text": "Follow up tomorrow"}`, bodies[0])
+
+	dueOn := time.Date(2017, 3, 22, 10, 0, 0, 0, time.UTC)
+	r.NoError(client.UpdateTask(context.Background(), 71844916, &UpdateTaskRequest{DueOn: &dueOn, LabelIds: []int64{}}))
+	r.JSONEq(`{"due_on": "2017-03-22 10:00:00", "label_ids": []}`, bodies[1])
+}
+
+func TestDeleteTask(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("DELETE", req.Method)
+		r.Equal("/task/71844916", req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.DeleteTask(context.Background(), 71844916))
+}
+
+func TestTaskContextCancelled(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Error("request should not have been sent")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetTask(ctx, 71844916)
+	r.Error(err)
+}
+
+func TestCompleteAndUncompleteTask(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.CompleteTask(context.Background(), 71844916))
+	r.NoError(client.UncompleteTask(context.Background(), 71844916))
+	r.Equal([]string{"POST /task/71844916/complete", "POST /task/71844916/incomplete"}, requests)
+}
+
+func TestAssignTask(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/task/71844916/assign", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"responsible": {"type": "profile", "id": 140798621}}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.AssignTask(context.Background(), 71844916, 140798621))
+}
+
+func TestCompleteTaskError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "The task cannot be completed"}`))
+	})
+
+	err := client.CompleteTask(context.Background(), 71844916)
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected *Error, got %T", err)
+	r.Equal("forbidden", podioErr.Type)
+}
+
+func TestGetTaskNotFound(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "not_found", "error_description": "Object not found"}`))
+	})
+
+	_, err := client.GetTask(context.Background(), 71844916)
+	podioErr, ok := err.(*Error)
+	r.True(ok, "Expected *Error, got %T", err)
+	r.Equal("not_found", podioErr.Type)
+	r.Equal(http.StatusNotFound, podioErr.HTTPStatusCode)
+	r.True(errors.Is(err, ErrNotFound))
+}
+
+func TestGetTaskLabels(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/task/label/", req.URL.Path)
+		w.Write([]byte(`[{"label_id": 12, "text": "Sales", "color": "E9E9E9"}, {"label_id": 13, "text": "Urgent", "color": "FF0000"}]`))
+	})
+
+	labels, err := client.GetTaskLabels(context.Background())
+	r.NoError(err)
+	r.Equal([]*TaskLabel{
+		{Id: 12, Text: "Sales", Color: "E9E9E9"},
+		{Id: 13, Text: "Urgent", Color: "FF0000"},
+	}, labels)
+}
+
+func TestCreateTaskLabel(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/task/label/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"text": "Urgent", "color": "FF0000"}`, string(body))
+
+		w.Write([]byte(`{"label_id": 13}`))
+	})
+
+	label, err := client.CreateTaskLabel(context.Background(), "Urgent", "FF0000")
+	r.NoError(err)
+	r.Equal(&TaskLabel{Id: 13, Text: "Urgent", Color: "FF0000"}, label)
+}
+
+func TestUpdateAndDeleteTaskLabel(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.UpdateTaskLabel(context.Background(), 13, "Very urgent", "FF0000"))
+	r.NoError(client.DeleteTaskLabel(context.Background(), 13))
+	r.Equal([]string{
+		`PUT /task/label/13 {"color":"FF0000","text":"Very urgent"}`,
+		`DELETE /task/label/13 `,
+	}, requests)
+}
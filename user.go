@@ -0,0 +1,102 @@
+package podio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// User is a Podio user account. The public information about the user is in Profile.
+type User struct {
+	Id        int64    `json:"user_id"`
+	Mail      string   `json:"mail"`
+	Status    string   `json:"status"`
+	Locale    string   `json:"locale"`
+	TimeZone  string   `json:"timezone"`
+	CreatedOn Time     `json:"created_on"`
+	Profile   *Profile `json:"-"`
+}
+
+// GetCurrentUser returns the authenticated user along with the profile of the user.
+//
+// https://developers.podio.com/doc/users/get-user-status-43066
+func (client *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	rsp := &struct {
+		User    *User    `json:"user"`
+		Profile *Profile `json:"profile"`
+	}{}
+	err := client.requestContext(ctx, "GET", "/user/status", nil, nil, rsp)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.User == nil {
+		return nil, errors.New("podio: no user in user status")
+	}
+
+	rsp.User.Profile = rsp.Profile
+	return rsp.User, nil
+}
+
+// GetUserProfile returns the profile of a user, as seen by the authenticated user
+//
+// https://developers.podio.com/doc/contacts/get-user-contact-60514
+func (client *Client) GetUserProfile(ctx context.Context, userId int64) (profile *Profile, err error) {
+	path := fmt.Sprintf("/contact/user/%d", userId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &profile)
+	return
+}
+
+// UpdateProfileRequest holds the new values of the profile of the authenticated user.
+// Empty values are left unchanged.
+type UpdateProfileRequest struct {
+	Name     string
+	Title    string
+	About    string
+	Location string
+}
+
+func (req *UpdateProfileRequest) params() map[string]interface{} {
+	params := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"name":     req.Name,
+		"title":    req.Title,
+		"about":    req.About,
+		"location": req.Location,
+	} {
+		if value != "" {
+			params[key] = value
+		}
+	}
+	return params
+}
+
+// UpdateUserProfile updates the profile of the authenticated user
+//
+// https://developers.podio.com/doc/users/update-profile-22402
+func (client *Client) UpdateUserProfile(ctx context.Context, req *UpdateProfileRequest) error {
+	return client.requestWithParamsContext(ctx, "PUT", "/user/profile/", nil, req.params(), nil)
+}
+
+// GetCurrentUserID returns the id of the user the client is authenticated as. The id is
+// only requested from Podio the first time; later calls return the cached id.
+//
+// https://developers.podio.com/doc/users/get-user-22378
+func (client *Client) GetCurrentUserID(ctx context.Context) (int64, error) {
+	client.userIdMu.Lock()
+	defer client.userIdMu.Unlock()
+
+	if client.userId != 0 {
+		return client.userId, nil
+	}
+
+	rsp := &struct {
+		UserId int64 `json:"user_id"`
+	}{}
+	err := client.requestContext(ctx, "GET", "/user/", nil, nil, rsp)
+	if err != nil {
+		return 0, err
+	}
+
+	client.userId = rsp.UserId
+	return client.userId, nil
+}
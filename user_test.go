@@ -0,0 +1,106 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCurrentUserID(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		r.Equal("GET", req.Method)
+		r.Equal("/user/", req.URL.Path)
+		w.Write([]byte(`{"user_id": 2468975, "mail": "brian@example.com", "status": "active"}`))
+	})
+
+	for i := 0; i < 3; i++ {
+		userId, err := client.GetCurrentUserID(context.Background())
+		r.NoError(err)
+		r.Equal(int64(2468975), userId)
+	}
+	r.Equal(1, requests)
+}
+
+func TestGetCurrentUserIDError(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error": "unauthorized", "error_description": "expired_token"}`))
+			return
+		}
+		w.Write([]byte(`{"user_id": 2468975}`))
+	})
+
+	_, err := client.GetCurrentUserID(context.Background())
+	r.EqualError(err, "unauthorized: expired_token")
+
+	// errors are not cached
+	userId, err := client.GetCurrentUserID(context.Background())
+	r.NoError(err)
+	r.Equal(int64(2468975), userId)
+}
+
+func TestGetCurrentUser(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/user/status", req.URL.Path)
+		w.Write([]byte(`{
+			"user": {"user_id": 2468975, "mail": "brian@example.com", "status": "active", "locale": "en_US", "timezone": "Europe/Copenhagen"},
+			"profile": {"user_id": 2468975, "profile_id": 140798621, "name": "Brian Stengaard"},
+			"inbox_new": 3
+		}`))
+	})
+
+	user, err := client.GetCurrentUser(context.Background())
+	r.NoError(err)
+	r.Equal(int64(2468975), user.Id)
+	r.Equal("brian@example.com", user.Mail)
+	r.Equal("Europe/Copenhagen", user.TimeZone)
+	r.Equal(140798621, user.Profile.ProfileId)
+	r.Equal("Brian Stengaard", user.Profile.Name)
+}
+
+func TestGetUserProfile(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/contact/user/1234567", req.URL.Path)
+		w.Write([]byte(`{"user_id": 1234567, "profile_id": 150000000, "name": "Another User"}`))
+	})
+
+	profile, err := client.GetUserProfile(context.Background(), 1234567)
+	r.NoError(err)
+	r.Equal(1234567, profile.UserId)
+	r.Equal("Another User", profile.Name)
+}
+
+func TestUpdateUserProfile(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("PUT", req.Method)
+		r.Equal("/user/profile/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{"name": "Brian Stengaard", "title": "Developer"}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.UpdateUserProfile(context.Background(), &UpdateProfileRequest{Name: "Brian Stengaard", Title: "Developer"}))
+}
@@ -0,0 +1,109 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppView is a saved view of the items in an app
+type AppView struct {
+	Id        int64                  `json:"view_id"`
+	Name      string                 `json:"name"`
+	Private   bool                   `json:"private"`
+	Layout    string                 `json:"layout"`
+	SortBy    string                 `json:"sort_by"`
+	SortDesc  bool                   `json:"sort_desc"`
+	Filters   []ViewFilter           `json:"filters"`
+	Fields    map[string]interface{} `json:"fields"`    // display settings of each field, keyed by field id
+	Groupings *ViewGrouping          `json:"groupings"` // nil if the items are not grouped
+	Items     int                    `json:"items"`
+	CreatedBy ByLine                 `json:"created_by"`
+	CreatedOn Time                   `json:"created_on"`
+}
+
+// ViewFilter is a single filter of a saved view. Key is a field id or an item property,
+// the values depend on the type of the field.
+type ViewFilter struct {
+	Key    string      `json:"key"`
+	Values interface{} `json:"values"`
+}
+
+// ViewGrouping is how the items of a saved view are grouped. Value is the field id or
+// item property the items are grouped by.
+type ViewGrouping struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// AppViewRequest describes a view to create or the new settings of a view.
+// The filters and sorting of the view are taken from Filter; limit and offset are ignored.
+type AppViewRequest struct {
+	Name    string
+	Private bool
+	Layout  string // badge, table, calendar, ...
+	Filter  *ItemFilterRequest
+}
+
+func (req *AppViewRequest) params() map[string]interface{} {
+	params := map[string]interface{}{
+		"name":    req.Name,
+		"private": req.Private,
+	}
+	if req.Layout != "" {
+		params["layout"] = req.Layout
+	}
+
+	if req.Filter != nil {
+		if len(req.Filter.Filters) > 0 {
+			params["filters"] = req.Filter.Filters
+		}
+		if req.Filter.SortBy != "" {
+			params["sort_by"] = req.Filter.SortBy
+			params["sort_desc"] = req.Filter.SortDesc
+		}
+	}
+	return params
+}
+
+// https://developers.podio.com/doc/views/get-views-27460
+func (client *Client) GetAppViews(ctx context.Context, appId int64) (views []*AppView, err error) {
+	path := fmt.Sprintf("/view/app/%d/", appId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &views)
+	return
+}
+
+// GetView returns a saved view of an app. It is not to be confused with GetAppView,
+// which returns the app itself.
+//
+// https://developers.podio.com/doc/views/get-view-27450
+func (client *Client) GetView(ctx context.Context, appId, viewId int64) (view *AppView, err error) {
+	path := fmt.Sprintf("/view/app/%d/%d", appId, viewId)
+	err = client.requestContext(ctx, "GET", path, nil, nil, &view)
+	return
+}
+
+// CreateAppView saves a new view on an app and returns the id of the view.
+//
+// https://developers.podio.com/doc/views/create-view-27453
+func (client *Client) CreateAppView(ctx context.Context, appId int64, req *AppViewRequest) (int64, error) {
+	path := fmt.Sprintf("/view/app/%d/", appId)
+
+	rsp := &struct {
+		ViewId int64 `json:"view_id"`
+	}{}
+	err := client.requestWithParamsContext(ctx, "POST", path, nil, req.params(), rsp)
+
+	return rsp.ViewId, err
+}
+
+// https://developers.podio.com/doc/views/update-view-20069949
+func (client *Client) UpdateAppView(ctx context.Context, viewId int64, req *AppViewRequest) error {
+	path := fmt.Sprintf("/view/%d", viewId)
+	return client.requestWithParamsContext(ctx, "PUT", path, nil, req.params(), nil)
+}
+
+// https://developers.podio.com/doc/views/delete-view-27454
+func (client *Client) DeleteAppView(ctx context.Context, viewId int64) error {
+	path := fmt.Sprintf("/view/%d", viewId)
+	return client.requestContext(ctx, "DELETE", path, nil, nil, nil)
+}
@@ -0,0 +1,124 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAppViews(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/view/app/18166054/", req.URL.Path)
+		w.Write([]byte(`[{
+			"view_id": 31384275,
+			"name": "Open tasks",
+			"private": false,
+			"layout": "table",
+			"sort_by": "created_on",
+			"sort_desc": true,
+			"items": 12,
+			"filters": [{"key": "142837427", "values": [1]}],
+			"created_by": {"id": 2468975, "type": "user", "name": "Brian Stengaard"},
+			"created_on": "2017-03-21 23:29:07"
+		}]`))
+	})
+
+	views, err := client.GetAppViews(context.Background(), 18166054)
+	r.NoError(err)
+	r.Len(views, 1)
+
+	view := views[0]
+	r.Equal(int64(31384275), view.Id)
+	r.Equal("Open tasks", view.Name)
+	r.Equal("table", view.Layout)
+	r.Equal("created_on", view.SortBy)
+	r.True(view.SortDesc)
+	r.Equal(12, view.Items)
+	r.Equal([]ViewFilter{{Key: "142837427", Values: []interface{}{float64(1)}}}, view.Filters)
+	r.Equal("Brian Stengaard", view.CreatedBy.Name)
+	r.Equal(*parseTime(t, "2017-03-21 23:29:07"), view.CreatedOn)
+}
+
+func TestGetView(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("GET", req.Method)
+		r.Equal("/view/app/18166054/31384275", req.URL.Path)
+		w.Write([]byte(`{
+			"view_id": 31384275,
+			"name": "By status",
+			"layout": "badge",
+			"filters": [{"key": "created_on", "values": {"from": "-7d"}}],
+			"fields": {"142837427": {"width": 120, "use": "title"}},
+			"groupings": {"type": "field", "value": 142837428}
+		}`))
+	})
+
+	view, err := client.GetView(context.Background(), 18166054, 31384275)
+	r.NoError(err)
+	r.Equal("By status", view.Name)
+	r.Equal([]ViewFilter{{Key: "created_on", Values: map[string]interface{}{"from": "-7d"}}}, view.Filters)
+	r.Equal(map[string]interface{}{"142837427": map[string]interface{}{"width": float64(120), "use": "title"}}, view.Fields)
+	r.Equal(&ViewGrouping{Type: "field", Value: float64(142837428)}, view.Groupings)
+}
+
+func TestGetViewWithoutGrouping(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"view_id": 31384275, "name": "All", "groupings": null}`))
+	})
+
+	view, err := client.GetView(context.Background(), 18166054, 31384275)
+	r.NoError(err)
+	r.Nil(view.Groupings)
+}
+
+func TestCreateAppView(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("POST", req.Method)
+		r.Equal("/view/app/18166054/", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		r.NoError(err)
+		r.JSONEq(`{
+			"name": "Open tasks",
+			"private": true,
+			"filters": {"status": [1]},
+			"sort_by": "created_on",
+			"sort_desc": true
+		}`, string(body))
+
+		w.Write([]byte(`{"view_id": 31384276}`))
+	})
+
+	filter, err := NewFilterBuilder().Where("status", "=", []int{1}).SortBy("created_on", true).Build()
+	r.NoError(err)
+
+	viewId, err := client.CreateAppView(context.Background(), 18166054, &AppViewRequest{Name: "Open tasks", Private: true, Filter: filter})
+	r.NoError(err)
+	r.Equal(int64(31384276), viewId)
+}
+
+func TestUpdateAndDeleteAppView(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.NoError(client.UpdateAppView(context.Background(), 31384276, &AppViewRequest{Name: "All tasks"}))
+	r.NoError(client.DeleteAppView(context.Background(), 31384276))
+	r.Equal([]string{"PUT /view/31384276", "DELETE /view/31384276"}, requests)
+}